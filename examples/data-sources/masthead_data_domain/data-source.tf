@@ -0,0 +1,8 @@
+data "masthead_data_domain" "sales" {
+  name = "Sales"
+}
+
+resource "masthead_data_product" "revenue" {
+  name             = "Revenue"
+  data_domain_uuid = data.masthead_data_domain.sales.uuid
+}
@@ -0,0 +1,10 @@
+data "masthead_data_domains" "all" {}
+
+# One alerting channel per data domain
+module "domain_alerts" {
+  source   = "./modules/alerting"
+  for_each = { for domain in data.masthead_data_domains.all.data_domains : domain.uuid => domain }
+
+  name  = each.value.name
+  email = each.value.email
+}
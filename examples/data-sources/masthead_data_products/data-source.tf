@@ -0,0 +1,9 @@
+# Every data product of a data domain with a data asset in the analytics project
+data "masthead_data_products" "sales_analytics" {
+  data_domain_uuid = masthead_data_domain.sales.uuid
+  asset_project    = "analytics"
+}
+
+output "sales_analytics_products" {
+  value = data.masthead_data_products.sales_analytics.data_products[*].name
+}
@@ -0,0 +1,11 @@
+data "masthead_users" "owners" {
+  role = "OWNER"
+}
+
+# Notify every account owner
+module "owner_alerts" {
+  source   = "./modules/alerting"
+  for_each = toset(data.masthead_users.owners.users[*].email)
+
+  email = each.value
+}
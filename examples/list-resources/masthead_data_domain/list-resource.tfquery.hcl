@@ -0,0 +1,9 @@
+# List all data domains whose name starts with "Sales", with their attributes
+list "masthead_data_domain" "sales" {
+  provider         = masthead
+  include_resource = true
+
+  config {
+    name_prefix = "Sales"
+  }
+}
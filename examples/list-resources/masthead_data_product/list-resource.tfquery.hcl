@@ -0,0 +1,8 @@
+# List the data products of a data domain
+list "masthead_data_product" "sales" {
+  provider = masthead
+
+  config {
+    data_domain_uuid = "5f8c2e4a-1b3d-4c6e-9f7a-2d4b6c8e0a1f"
+  }
+}
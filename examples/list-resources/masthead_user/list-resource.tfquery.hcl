@@ -0,0 +1,8 @@
+# List the users with the USER role
+list "masthead_user" "users" {
+  provider = masthead
+
+  config {
+    role = "USER"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = masthead_data_domain.sales
+  identity = {
+    uuid = "5f8c2e4a-1b3d-4c6e-9f7a-2d4b6c8e0a1f"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = masthead_data_domain.sales
+  id = "name:Sales"
+}
@@ -0,0 +1,5 @@
+# Import a data domain by UUID
+terraform import masthead_data_domain.sales 5f8c2e4a-1b3d-4c6e-9f7a-2d4b6c8e0a1f
+
+# Import a data domain by name
+terraform import masthead_data_domain.sales "name:Sales"
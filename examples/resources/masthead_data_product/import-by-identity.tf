@@ -0,0 +1,6 @@
+import {
+  to = masthead_data_product.revenue
+  identity = {
+    uuid = "9a1b2c3d-4e5f-4a6b-8c7d-0e1f2a3b4c5d"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = masthead_data_product.revenue
+  id = "name:Sales/Revenue"
+}
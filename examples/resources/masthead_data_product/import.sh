@@ -0,0 +1,5 @@
+# Import a data product by UUID
+terraform import masthead_data_product.revenue 9a1b2c3d-4e5f-4a6b-8c7d-0e1f2a3b4c5d
+
+# Import a data product by the names of its data domain and of the data product
+terraform import masthead_data_product.revenue "name:Sales/Revenue"
@@ -0,0 +1,6 @@
+import {
+  to = masthead_user.jane
+  identity = {
+    email = "jane@example.com"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = masthead_user.jane
+  id = "jane@example.com"
+}
@@ -0,0 +1,2 @@
+# Import a user by email address
+terraform import masthead_user.jane jane@example.com
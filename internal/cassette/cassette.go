@@ -0,0 +1,357 @@
+// Package cassette records HTTP exchanges with the Masthead API into sanitized
+// fixture files, and replays them through an http.RoundTripper so that client
+// tests run against recorded payloads without network access. A cassette only
+// reflects the server it was recorded from, which may be a fake.
+//
+// API tokens are never recorded, and email addresses outside of the example
+// domains are replaced with stable placeholders, both in requests and responses.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ModeEnvVar - Environment variable selecting the cassette mode, replay if unset
+const ModeEnvVar = "MASTHEAD_CASSETTE_MODE"
+
+// Mode selects whether a Recorder talks to the API or serves a cassette
+type Mode string
+
+const (
+	// ModeReplay serves the recorded interactions, without network access
+	ModeReplay Mode = "replay"
+	// ModeRecord forwards requests to the API and records the interactions
+	ModeRecord Mode = "record"
+)
+
+// ModeFromEnv returns the mode set in ModeEnvVar, defaulting to ModeReplay
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(os.Getenv(ModeEnvVar)); mode {
+	case "", ModeReplay:
+		return ModeReplay, nil
+	case ModeRecord:
+		return ModeRecord, nil
+	default:
+		return "", fmt.Errorf("invalid %s %q, expected %q or %q", ModeEnvVar, mode, ModeRecord, ModeReplay)
+	}
+}
+
+// Cassette is the content of a fixture file
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. URL holds the path and query only.
+type Request struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in cassettes
+var recordedHeaders = []string{"Content-Type", "Retry-After", "X-Request-Id"}
+
+// Recorder is an http.RoundTripper that records or replays a cassette.
+// It is safe for concurrent use.
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// New returns a Recorder for the cassette file at path.
+//
+// In replay mode the cassette is loaded and must exist. In record mode,
+// requests are sent through transport, or http.DefaultTransport if nil, and
+// the cassette is written by Save.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path, transport: transport}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load cassette: %w", err)
+		}
+		var cassette Cassette
+		if err := json.Unmarshal(data, &cassette); err != nil {
+			return nil, fmt.Errorf("unable to decode cassette %s: %w", path, err)
+		}
+		r.interactions = cassette.Interactions
+		r.replayed = make([]bool, len(cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Load reads the interactions of a cassette file
+func Load(path string) ([]Interaction, error) {
+	r, err := New(path, ModeReplay, nil)
+	if err != nil {
+		return nil, err
+	}
+	return r.interactions, nil
+}
+
+// Mode returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, request, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, request)
+	}
+	return r.replay(req, request)
+}
+
+// record forwards the request and stores the sanitized interaction
+func (r *Recorder) record(req *http.Request, request Request) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := Response{Status: res.StatusCode, Body: sanitizeBody(body)}
+	for _, name := range recordedHeaders {
+		if value := res.Header.Get(name); value != "" {
+			if response.Headers == nil {
+				response.Headers = map[string]string{}
+			}
+			response.Headers[name] = value
+		}
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{Request: request, Response: response})
+	r.mu.Unlock()
+
+	return res, nil
+}
+
+// replay serves the first recorded interaction matching the request that was
+// not served yet, so that repeated requests get the responses in recorded order
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || !interaction.Request.matches(request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		res := &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode: interaction.Response.Status,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(rawBody(interaction.Response.Body))),
+			Request:    req,
+		}
+		for name, value := range interaction.Response.Headers {
+			res.Header.Set(name, value)
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in cassette %s", request.Method, request.URL, r.path)
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(Cassette{Interactions: r.interactions}); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data.Bytes(), 0o644)
+}
+
+// Unused returns the interactions of the cassette that were not replayed,
+// which usually means that the client sends fewer requests than when recorded
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, replayed := range r.replayed {
+		if !replayed {
+			unused = append(unused, r.interactions[i])
+		}
+	}
+	return unused
+}
+
+// newRequest returns a copy of the request with a readable body, and its sanitized form
+func newRequest(req *http.Request) (*http.Request, Request, error) {
+	request := Request{
+		Method: req.Method,
+		URL:    sanitizeURL(req.URL),
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, request, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, Request{}, err
+	}
+	request.Body = sanitizeBody(body)
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return req, request, nil
+}
+
+// matches reports whether two requests are the same, comparing JSON bodies semantically
+func (r Request) matches(other Request) bool {
+	if r.Method != other.Method || r.URL != other.URL {
+		return false
+	}
+	if len(r.Body) == 0 || len(other.Body) == 0 {
+		return len(r.Body) == len(other.Body)
+	}
+
+	var a, b any
+	if json.Unmarshal(r.Body, &a) != nil || json.Unmarshal(other.Body, &b) != nil {
+		return bytes.Equal(r.Body, other.Body)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// emailAddress matches email addresses in URLs and bodies
+var emailAddress = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// secretJSONValues matches values of secret looking keys in JSON bodies
+var secretJSONValues = regexp.MustCompile(`(?i)("[a-z_]*(?:token|secret|password|key)"\s*:\s*)"[^"]*"`)
+
+// exampleDomains are email domains reserved for documentation, kept as is
+var exampleDomains = []string{"example.com", "example.org", "example.net"}
+
+// sanitizeEmail replaces an email address with a stable placeholder, unless
+// it belongs to an example domain
+func sanitizeEmail(email string) string {
+	domain := email[strings.LastIndex(email, "@")+1:]
+	for _, example := range exampleDomains {
+		if strings.EqualFold(domain, example) {
+			return email
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.ToLower(email)))
+	return fmt.Sprintf("user-%s@example.com", hex.EncodeToString(sum[:4]))
+}
+
+// sanitizeURL returns the path and query of a URL with email addresses scrubbed
+func sanitizeURL(u *url.URL) string {
+	path := emailAddress.ReplaceAllStringFunc(u.Path, sanitizeEmail)
+
+	query := u.Query()
+	for _, values := range query {
+		for i, value := range values {
+			values[i] = emailAddress.ReplaceAllStringFunc(value, sanitizeEmail)
+		}
+	}
+
+	sanitized := url.URL{Path: path, RawQuery: query.Encode()}
+	return sanitized.RequestURI()
+}
+
+// sanitizeBody scrubs secrets and email addresses from a body. JSON bodies
+// are kept as JSON, other bodies are stored as a JSON string.
+func sanitizeBody(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	sanitized := secretJSONValues.ReplaceAll(body, []byte(`$1"[REDACTED]"`))
+	sanitized = emailAddress.ReplaceAllFunc(sanitized, func(email []byte) []byte {
+		return []byte(sanitizeEmail(string(email)))
+	})
+
+	if !json.Valid(sanitized) {
+		sanitized, _ = json.Marshal(string(sanitized))
+		return sanitized
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, sanitized); err != nil {
+		return sanitized
+	}
+	return compacted.Bytes()
+}
+
+// rawBody returns the response body as served by the API
+func rawBody(body json.RawMessage) []byte {
+	var text string
+	if len(body) > 0 && body[0] == '"' && json.Unmarshal(body, &text) == nil {
+		return []byte(text)
+	}
+	return body
+}
+
+// IsNotExist reports whether the error is caused by a missing cassette file
+func IsNotExist(err error) bool {
+	return errors.Is(err, os.ErrNotExist)
+}
@@ -0,0 +1,96 @@
+package cassette
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("Set-Cookie", "session=secret")
+		fmt.Fprintf(w, `{"value":{"email":"jane.doe@acme.io","apiToken":"tok-123","echo":%q}}`, body)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "users.json")
+	send := func(client *http.Client) (string, error) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/clientApi/user?email=jane.doe@acme.io", strings.NewReader(`{"email":"jane.doe@acme.io","role":"USER"}`))
+		assert.NoError(t, err)
+		req.Header.Set("X-API-TOKEN", "secret-token")
+		res, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		return string(body), err
+	}
+
+	// Record mode returns the real response and saves a sanitized cassette
+	recorder, err := New(path, ModeRecord, nil)
+	assert.NoError(t, err)
+	body, err := send(&http.Client{Transport: recorder})
+	assert.NoError(t, err)
+	assert.Contains(t, body, "jane.doe@acme.io")
+	assert.NoError(t, recorder.Save())
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	for _, secret := range []string{"jane.doe", "acme.io", "tok-123", "secret-token", "session"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	assert.Contains(t, string(data), "req-1", "Request IDs should be recorded")
+
+	// Replay mode serves the cassette without the server
+	server.Close()
+	recorder, err = New(path, ModeReplay, nil)
+	assert.NoError(t, err)
+	body, err = send(&http.Client{Transport: recorder})
+	assert.NoError(t, err)
+	assert.Contains(t, body, sanitizeEmail("jane.doe@acme.io"))
+	assert.Contains(t, body, "[REDACTED]")
+	assert.Empty(t, recorder.Unused())
+
+	// Each interaction is served once
+	_, err = send(&http.Client{Transport: recorder})
+	assert.ErrorContains(t, err, "no recorded interaction left for POST /clientApi/user")
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil)
+	assert.True(t, IsNotExist(err))
+}
+
+func TestSanitizeEmail(t *testing.T) {
+	assert.Equal(t, "user@example.com", sanitizeEmail("user@example.com"))
+	assert.Equal(t, sanitizeEmail("Jane@Acme.io"), sanitizeEmail("jane@acme.io"), "Placeholders should be stable")
+	assert.NotEqual(t, sanitizeEmail("jane@acme.io"), sanitizeEmail("john@acme.io"))
+	assert.True(t, strings.HasSuffix(sanitizeEmail("jane@acme.io"), "@example.com"))
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(ModeEnvVar, "")
+	mode, err := ModeFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, ModeReplay, mode)
+
+	t.Setenv(ModeEnvVar, "record")
+	mode, err = ModeFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, ModeRecord, mode)
+
+	t.Setenv(ModeEnvVar, "live")
+	_, err = ModeFromEnv()
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package masthead
+
+import (
+	"context"
+	"iter"
+)
+
+// TokenAPI covers the API token introspection of the Masthead API
+type TokenAPI interface {
+	CurrentToken(ctx context.Context) (*TokenInfo, error)
+}
+
+// UserAPI covers the user operations of the Masthead API
+type UserAPI interface {
+	ListUsers(ctx context.Context, filter UserFilter) ([]User, error)
+	IterUsers(ctx context.Context, filter UserFilter) iter.Seq2[User, error]
+	CreateUser(ctx context.Context, user User) (*User, error)
+	UpdateUserRole(ctx context.Context, user User) (*User, error)
+	DeleteUser(ctx context.Context, email string) error
+}
+
+// DataDomainAPI covers the data domain operations of the Masthead API
+type DataDomainAPI interface {
+	ListDomains(ctx context.Context) ([]DataDomain, error)
+	IterDomains(ctx context.Context) iter.Seq2[DataDomain, error]
+	CreateDomain(ctx context.Context, dataDomain DataDomain) (*DataDomain, error)
+	GetDomain(ctx context.Context, dataDomainID string) (*DataDomain, error)
+	UpdateDomain(ctx context.Context, dataDomain DataDomain) (*DataDomain, error)
+	DeleteDomain(ctx context.Context, domainID string) error
+}
+
+// DataProductAPI covers the data product operations of the Masthead API
+type DataProductAPI interface {
+	ListDataProducts(ctx context.Context) ([]DataProduct, error)
+	IterDataProducts(ctx context.Context) iter.Seq2[DataProduct, error]
+	CreateDataProduct(ctx context.Context, dataProduct DataProduct) (*DataProduct, error)
+	GetDataProduct(ctx context.Context, productID string) (*DataProduct, error)
+	UpdateDataProduct(ctx context.Context, dataProduct DataProduct) (*DataProduct, error)
+	DeleteDataProduct(ctx context.Context, productID string) error
+}
+
+// MastheadAPI is the set of Masthead API operations used by the provider.
+//
+// Client is the HTTP implementation. Resources and data sources only depend
+// on this interface, so that they can be tested with mocks and wrapped by
+// alternative implementations, e.g. for caching or recording.
+type MastheadAPI interface {
+	TokenAPI
+	UserAPI
+	DataDomainAPI
+	DataProductAPI
+}
+
+// Ensure Client implements the whole API
+var _ MastheadAPI = (*Client)(nil)
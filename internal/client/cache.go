@@ -0,0 +1,98 @@
+package masthead
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultUserCacheTTL - How long a user list snapshot is reused.
+// It covers a single refresh, where every masthead_user reads the same list.
+const DefaultUserCacheTTL = 30 * time.Second
+
+// WithUserCacheTTL sets how long user list snapshots are reused. A ttl of 0 disables caching.
+func WithUserCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.users.ttl = ttl
+	}
+}
+
+// userCache keeps short-lived snapshots of the user list, so that concurrent
+// and repeated reads share a single API call. It is safe for concurrent use.
+type userCache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu         sync.Mutex
+	generation uint64
+	snapshots  map[UserFilter]userSnapshot
+}
+
+type userSnapshot struct {
+	users   []User
+	expires time.Time
+}
+
+func newUserCache(ttl time.Duration) *userCache {
+	return &userCache{
+		ttl:       ttl,
+		snapshots: map[UserFilter]userSnapshot{},
+	}
+}
+
+// list returns the cached users for the filter, calling fetch at most once
+// for all concurrent callers when there is no fresh snapshot.
+func (uc *userCache) list(filter UserFilter, fetch func() ([]User, error)) ([]User, error) {
+	if uc == nil || uc.ttl <= 0 {
+		return fetch()
+	}
+
+	uc.mu.Lock()
+	snapshot, ok := uc.snapshots[filter]
+	generation := uc.generation
+	uc.mu.Unlock()
+
+	if ok && time.Now().Before(snapshot.expires) {
+		return slices.Clone(snapshot.users), nil
+	}
+
+	// Keying on the generation keeps callers arriving after an invalidation
+	// from joining a call that started before it
+	key := fmt.Sprintf("%d\x00%s\x00%s", generation, filter.Email, filter.Role)
+	result, err, _ := uc.group.Do(key, func() (any, error) {
+		users, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		uc.mu.Lock()
+		// Do not store a snapshot fetched before a user was changed
+		if uc.generation == generation {
+			uc.snapshots[filter] = userSnapshot{users: users, expires: time.Now().Add(uc.ttl)}
+		}
+		uc.mu.Unlock()
+
+		return users, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(result.([]User)), nil
+}
+
+// invalidate drops all snapshots after a user was created, updated or deleted
+func (uc *userCache) invalidate() {
+	if uc == nil {
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.generation++
+	clear(uc.snapshots)
+}
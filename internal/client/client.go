@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
+	"strings"
 	"time"
 )
 
@@ -14,34 +14,80 @@ const HostURL string = "https://metadata.mastheadata.com"
 // TokenEnvVar - Environment variable for the Masthead API token
 const TokenEnvVar string = "MASTHEAD_API_TOKEN"
 
+// TokenFileEnvVar - Environment variable for the path of a file holding the Masthead API token
+const TokenFileEnvVar string = "MASTHEAD_API_TOKEN_FILE"
+
+// HostURLEnvVar - Environment variable for the Masthead API URL
+const HostURLEnvVar string = "MASTHEAD_HOST_URL"
+
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+
+	// Retry policy for transient failures (429, 502, 503, 504 and network errors)
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+
+	// Number of items requested per page by list calls
+	PageSize int
+
+	// Short-lived snapshots of the user list shared by all readers
+	users *userCache
+
+	// Concurrency and rate limits
+	limiter *Limiter
+
+	// User-Agent header sent with every request, Go's default if empty
+	UserAgent string
+
+	// Source of the API token when it is not static, see WithTokenSource
+	tokens TokenSource
+}
+
+// Option configures optional Client settings
+type Option func(*Client)
+
+// WithHostURL sets the base URL of the Masthead API, e.g. a staging tenant or a mock server
+func WithHostURL(hostURL string) Option {
+	return func(c *Client) {
+		c.HostURL = strings.TrimRight(hostURL, "/")
+	}
 }
 
-func NewClient(token *string) (*Client, error) {
+// WithUserAgent sets the User-Agent header identifying the application calling the API
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// NewClient returns a client authenticating with token, or with the token
+// source set by WithTokenSource. It does not read the environment: resolving
+// the token from the provider configuration or MASTHEAD_API_TOKEN is up to the caller.
+func NewClient(token *string, opts ...Option) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
 		// Default Masthead URL
-		HostURL: HostURL,
+		HostURL:      HostURL,
+		MaxRetries:   DefaultMaxRetries,
+		RetryMinWait: DefaultRetryMinWait,
+		RetryMaxWait: DefaultRetryMaxWait,
+		PageSize:     DefaultPageSize,
+		users:        newUserCache(DefaultUserCacheTTL),
 	}
 
 	if token != nil {
 		c.Token = *token
-	} else if token := os.Getenv(TokenEnvVar); token != "" {
-		// If the token is not provided, check for the environment variable
-		// and set it as the token.
-		// This allows the user to set the token in their environment
-		// without having to pass it explicitly.
-		// This is useful for CI/CD pipelines or other automated environments.
-		// The environment variable is expected to be set as "MASTHEAD
-		// _TOKEN" and will be used as the default token if not provided.
-		c.Token = token
-	} else {
-		// If the token is not provided and the environment variable is not set,
-		// return an error indicating that the token is required.
-		return nil, fmt.Errorf("masthead API token is required. Set the token in the configuration or use the %s environment variable", TokenEnvVar)
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if c.Token == "" && c.tokens == nil {
+		return nil, fmt.Errorf("masthead API token is required. Pass a token or set a token source with WithTokenSource")
 	}
 
 	return &c, nil
@@ -50,35 +96,97 @@ func NewClient(token *string) (*Client, error) {
 // doRequest performs an HTTP request and processes the response.
 //
 // It sets the authentication token in the request header if available,
-// executes the request, and handles the response. If the response status
-// is not OK (200), it returns an error with the status code and response body.
+// executes the request, and handles the response. Transient failures are
+// retried according to the client retry policy. If the final response status
+// is not OK (200), it returns an *APIError describing the failure.
 //
 // Parameters:
 //   - req: The HTTP request to be executed
 //
 // Returns:
 //   - []byte: The response body as a byte slice
-//   - error: An error if the request fails, the response cannot be read, or the status code is not 200
+//   - error: An error if the request fails or the response cannot be read, or an *APIError if the status code is not 200
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	if c.Token != "" {
-		req.Header.Set("X-API-TOKEN", c.Token)
-		req.Header.Set("Content-Type", "application/json")
-	}
-
-	res, err := c.HTTPClient.Do(req)
+	token, err := c.token(req.Context())
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	req.Header.Set("Content-Type", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
-	}
+	ctx := c.logContext(req.Context(), token)
+	refreshed := false
+
+	for attempt := 0; ; attempt++ {
+		req.Header.Set("X-API-TOKEN", token)
+
+		if (attempt > 0 || refreshed) && req.GetBody != nil {
+			// Rewind the request body consumed by the previous attempt
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
 
-	return body, err
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		logRequest(ctx, req, attempt)
+		start := time.Now()
+
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			release()
+			if attempt < c.MaxRetries && ctx.Err() == nil && shouldRetryError(req.Method, err) {
+				wait := c.retryWait(attempt, nil)
+				logRetry(ctx, req, err.Error(), wait)
+				if err := sleep(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		release()
+		if err != nil {
+			return nil, err
+		}
+
+		logResponse(ctx, req, res, body, time.Since(start))
+
+		if res.StatusCode == http.StatusOK {
+			return body, nil
+		}
+
+		if res.StatusCode == http.StatusUnauthorized && c.tokens != nil && !refreshed {
+			// The token may have been rotated since it was read, read it again once
+			c.tokens.Invalidate()
+			if token, err = c.token(ctx); err != nil {
+				return nil, err
+			}
+			ctx = c.logContext(ctx, token)
+			refreshed = true
+			attempt--
+			continue
+		}
+
+		if attempt < c.MaxRetries && shouldRetryStatus(req.Method, res.StatusCode) {
+			wait := c.retryWait(attempt, res)
+			logRetry(ctx, req, res.Status, wait)
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		return nil, newAPIError(res, body)
+	}
 }
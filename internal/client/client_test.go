@@ -1,22 +1,50 @@
 package masthead
 
 import (
+	"bytes"
+	"context"
+	"encoding/pem"
 	"fmt"
-	"testing"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
 )
 
-// TestClient is a placeholder test function to make the test file valid
+// TestClient runs the API operations against the in-memory fake API, or
+// against the real Masthead API when MASTHEAD_LIVE_TESTS is set. Live runs
+// create and delete a user, a data domain and a data product, so they are
+// never enabled by TF_ACC alone.
 func TestClient(t *testing.T) {
-	// Retrieve API token from the MASTHEAD_API_TOKEN environment variable
-	apiToken := os.Getenv("MASTHEAD_API_TOKEN")
-	assert.NotEmpty(t, apiToken, "API token should not be empty")
+	var apiToken string
+	var opts []Option
+
+	if os.Getenv(liveTestsEnvVar) != "" {
+		// Retrieve API token from the MASTHEAD_API_TOKEN environment variable
+		apiToken = os.Getenv(TokenEnvVar)
+		if apiToken == "" {
+			t.Skipf("%s must be set to run the live API tests", TokenEnvVar)
+		}
+	} else {
+		server := fakeapi.New()
+		defer server.Close()
+
+		apiToken = server.Token
+		opts = append(opts, WithHostURL(server.URL))
+	}
 
 	// Instantiate a new Masthead API client using the retrieved token
-	apiClient, err := NewClient(&apiToken)
-	assert.NoError(t, err, "Client creation should not return an error")
+	apiClient, err := NewClient(&apiToken, opts...)
+	require.NoError(t, err, "Client creation should not return an error")
 
 	t.Log("Masthead API client created successfully")
 
@@ -25,21 +53,26 @@ func TestClient(t *testing.T) {
 	fmt.Println("Completed successfully")
 }
 
+// liveTestsEnvVar - Environment variable enabling tests against the real Masthead API
+const liveTestsEnvVar = "MASTHEAD_LIVE_TESTS"
+
 // userExample demonstrates the User API operations
 func apiClientExample(client *Client, t *testing.T) {
+	ctx := context.Background()
+
 	testUser := User{
 		Email: "testuser@example.com",
 		Role:  "USER",
 	}
 
-	user, err := client.CreateUser(testUser)
+	user, err := client.CreateUser(ctx, testUser)
 	assert.NoError(t, err, "User creation should not return an error")
 	if err == nil {
 		t.Logf("User %s created successfully with role %s\n", user.Email, user.Role)
 	}
 
 	// Call ListUsers to retrieve a list of users
-	users, err := client.ListUsers()
+	users, err := client.ListUsers(ctx, UserFilter{})
 	assert.NoError(t, err, "User listing should not return an error")
 	if err == nil {
 		t.Logf("List of users:")
@@ -52,14 +85,14 @@ func apiClientExample(client *Client, t *testing.T) {
 	testUser.Role = "OWNER"
 
 	// Call UpdateUserRole for a user
-	user, err = client.UpdateUserRole(testUser)
+	user, err = client.UpdateUserRole(ctx, testUser)
 	assert.NoError(t, err, "User role update should not return an error")
 	if err == nil {
 		t.Logf("User %s role updated to %s\n", user.Email, user.Role)
 	}
 
 	// Call DeleteUser for a user
-	err = client.DeleteUser(user.Email)
+	err = client.DeleteUser(ctx, user.Email)
 	assert.NoError(t, err, "User deletion should not return an error")
 	if err == nil {
 		t.Logf("User %s deleted successfully\n", user.Email)
@@ -75,7 +108,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	}
 
 	// Call CreateDomain with sample data
-	domain, err := client.CreateDomain(testDomain)
+	domain, err := client.CreateDomain(ctx, testDomain)
 	assert.NoError(t, err, "Data domain creation should not return an error")
 	if err == nil {
 		fmt.Printf("Data domain '%s' created successfully\n", domain.Name)
@@ -83,7 +116,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	}
 
 	// Call ListDomains to retrieve a list of data domains
-	domains, err := client.ListDomains()
+	domains, err := client.ListDomains(ctx)
 	assert.NoError(t, err, "Data domain listing should not return an error")
 	if err == nil {
 		t.Logf("List of data domains:")
@@ -96,7 +129,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	assert.NotEmpty(t, testDomain.UUID, "Test data domain UUID should not be empty")
 	if testDomain.UUID != "" {
 		// Get a specific domain
-		domain, err = client.GetDomain(testDomain.UUID)
+		domain, err = client.GetDomain(ctx, testDomain.UUID)
 		assert.NoError(t, err, "Data domain retrieval should not return an error")
 		if err == nil {
 			t.Logf("Retrieved data domain: %s (ID: %s)\n", domain.Name, domain.UUID)
@@ -105,7 +138,7 @@ func apiClientExample(client *Client, t *testing.T) {
 		// Update the data domain
 		testDomain.Name = testDomain.Name + " (Updated)"
 		testDomain.SlackChannelName = ""
-		domain, err = client.UpdateDomain(testDomain)
+		domain, err = client.UpdateDomain(ctx, testDomain)
 		assert.NoError(t, err, "Data domain update should not return an error")
 		if err == nil {
 			t.Logf("Data domain updated to '%s'\n", domain.Name)
@@ -137,7 +170,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	}
 
 	// Call CreateDataProduct with sample data
-	dataProduct, err := client.CreateDataProduct(testProduct)
+	dataProduct, err := client.CreateDataProduct(ctx, testProduct)
 	assert.NoError(t, err, "Data product creation should not return an error")
 	if err == nil {
 		t.Logf("Data product '%s' created successfully\n", dataProduct.Name)
@@ -148,7 +181,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	}
 
 	// Call ListDataProducts to retrieve a list of data products
-	dataProducts, err := client.ListDataProducts()
+	dataProducts, err := client.ListDataProducts(ctx)
 	assert.NoError(t, err, "Data product listing should not return an error")
 	if err == nil {
 		t.Logf("List of data products:")
@@ -164,7 +197,7 @@ func apiClientExample(client *Client, t *testing.T) {
 	assert.NotEmpty(t, testProduct.UUID, "Test data product UUID should not be empty")
 	if testProduct.UUID != "" {
 		// Get a specific data product
-		dataProduct, err := client.GetDataProduct(testProduct.UUID)
+		dataProduct, err := client.GetDataProduct(ctx, testProduct.UUID)
 		assert.NoError(t, err, "Data product retrieval should not return an error")
 		if err == nil {
 			t.Logf("\nRetrieved data product: %s (ID: %s)\n", dataProduct.Name, dataProduct.UUID)
@@ -186,24 +219,492 @@ func apiClientExample(client *Client, t *testing.T) {
 			Table:   "pages_10k",
 		})
 
-		dataProduct, err = client.UpdateDataProduct(testProduct)
+		dataProduct, err = client.UpdateDataProduct(ctx, testProduct)
 		assert.NoError(t, err, "Data product update should not return an error")
 		if err == nil {
 			t.Logf("\nData product updated to '%s' with %d assets\n", dataProduct.Name, len(dataProduct.DataAssets))
 		}
 
 		// Delete the data product
-		err = client.DeleteDataProduct(testProduct.UUID)
+		err = client.DeleteDataProduct(ctx, testProduct.UUID)
 		assert.NoError(t, err, "Data product deletion should not return an error")
 		if err == nil {
 			t.Logf("\nData product '%s' (ID: %s) deleted successfully\n", testProduct.Name, testProduct.UUID)
 		}
 
 		// Delete the data domain
-		err = client.DeleteDomain(testDomain.UUID)
+		err = client.DeleteDomain(ctx, testDomain.UUID)
 		assert.NoError(t, err, "Data domain deletion should not return an error")
 		if err == nil {
 			t.Logf("Data domain '%s' (ID: %s) deleted successfully\n", testDomain.Name, testDomain.UUID)
 		}
 	}
 }
+
+// TestClientContextCancel ensures in-flight requests stop once the caller's context is cancelled
+func TestClientContextCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token)
+	assert.NoError(t, err)
+	apiClient.HostURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = apiClient.ListDomains(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), apiClient.HTTPClient.Timeout, "Request should stop before the client timeout")
+}
+
+// TestClientUserAgent ensures the configured User-Agent is sent with every request
+func TestClientUserAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+		fmt.Fprint(w, `{"values":[],"pagination":{"total":0,"page":1}}`)
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithHostURL(server.URL), WithUserAgent("terraform-provider-masthead/1.2.3 (+terraform 1.9.0)"))
+	assert.NoError(t, err)
+
+	_, err = apiClient.ListDomains(context.Background())
+	assert.NoError(t, err)
+	_, err = apiClient.ListDataProducts(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"terraform-provider-masthead/1.2.3 (+terraform 1.9.0)", "terraform-provider-masthead/1.2.3 (+terraform 1.9.0)"}, userAgents)
+}
+
+// TestClientRetry ensures transient failures are retried and non-idempotent requests are not replayed
+func TestClientRetry(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"value":{"uuid":"d1","name":"Domain"}}`)
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithRetry(3, time.Millisecond, 10*time.Millisecond))
+	assert.NoError(t, err)
+	apiClient.HostURL = server.URL
+
+	domain, err := apiClient.GetDomain(context.Background(), "d1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Domain", domain.Name)
+	assert.Equal(t, 3, calls, "GET should be retried until it succeeds")
+
+	calls = 0
+	_, err = apiClient.CreateDomain(context.Background(), DataDomain{Name: "Domain"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls, "POST should not be retried on 503")
+}
+
+// TestClientFaults ensures requests and list loops behave under the faults injected by the fake API
+func TestClientFaults(t *testing.T) {
+	ctx := context.Background()
+
+	// newFaultClient starts a fake API with five data domains and a client with fast retries
+	newFaultClient := func(t *testing.T, opts ...Option) (*fakeapi.Server, *Client) {
+		server := fakeapi.New()
+		t.Cleanup(server.Close)
+		for i := range 5 {
+			server.AddDomain(fakeapi.DataDomain{Name: fmt.Sprintf("Domain %d", i), Email: "domain@example.com"})
+		}
+
+		opts = append([]Option{WithHostURL(server.URL), WithPageSize(2), WithRetry(2, time.Millisecond, 10*time.Millisecond)}, opts...)
+		apiClient, err := NewClient(&server.Token, opts...)
+		assert.NoError(t, err)
+		return server, apiClient
+	}
+	const domainUUID = "00000000-0000-4000-8000-000000000001"
+	const domainPath = "/clientApi/data-domain/" + domainUUID
+
+	t.Run("429 with Retry-After on the Nth call", func(t *testing.T) {
+		// Retry-After is capped by the maximum wait
+		server, apiClient := newFaultClient(t, WithRetry(2, time.Millisecond, 2*time.Second))
+		server.InjectFault(fakeapi.Fault{Path: "/clientApi/data-domain/list", Nth: 2, Status: http.StatusTooManyRequests, RetryAfter: "1"})
+
+		start := time.Now()
+		domains, err := apiClient.ListDomains(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, domains, 5, "The throttled page should be retried")
+		assert.GreaterOrEqual(t, time.Since(start), time.Second, "Retry-After should be honored")
+		assert.Equal(t, 4, server.Requests())
+	})
+
+	t.Run("5xx burst", func(t *testing.T) {
+		server, apiClient := newFaultClient(t)
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Times: 2, Status: http.StatusServiceUnavailable})
+
+		domain, err := apiClient.GetDomain(ctx, domainUUID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Domain 0", domain.Name)
+		assert.Equal(t, 3, server.Requests(), "GET should be retried through the burst")
+
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Times: 3, Status: http.StatusBadGateway})
+		_, err = apiClient.GetDomain(ctx, domainUUID)
+		var apiErr *APIError
+		assert.ErrorAs(t, err, &apiErr, "A burst longer than the retries should surface the error")
+		assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+
+		server.InjectFault(fakeapi.Fault{Method: http.MethodPost, Times: 2, Status: http.StatusServiceUnavailable})
+		requests := server.Requests()
+		_, err = apiClient.CreateDomain(ctx, DataDomain{Name: "Domain", Email: "domain@example.com"})
+		assert.Error(t, err)
+		assert.Equal(t, requests+1, server.Requests(), "POST should not be retried on 503")
+	})
+
+	t.Run("delayed response", func(t *testing.T) {
+		server, apiClient := newFaultClient(t, WithTimeout(100*time.Millisecond))
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Delay: time.Second})
+
+		domain, err := apiClient.GetDomain(ctx, domainUUID)
+		assert.NoError(t, err, "A request timing out should be retried")
+		assert.Equal(t, "Domain 0", domain.Name)
+		assert.Equal(t, 2, server.Requests())
+
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Times: -1, Delay: time.Second})
+		deadline, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = apiClient.GetDomain(deadline, domainUUID)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("truncated JSON", func(t *testing.T) {
+		server, apiClient := newFaultClient(t)
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Truncate: true})
+
+		_, err := apiClient.GetDomain(ctx, domainUUID)
+		assert.ErrorContains(t, err, "unexpected end of JSON input")
+		assert.Equal(t, 1, server.Requests())
+
+		server.InjectFault(fakeapi.Fault{Path: "/clientApi/data-domain/list", Nth: 2, Truncate: true})
+		_, err = apiClient.ListDomains(ctx)
+		assert.Error(t, err, "A truncated page should fail the whole list")
+	})
+
+	t.Run("error envelope with HTTP 200", func(t *testing.T) {
+		server, apiClient := newFaultClient(t)
+		server.InjectFault(fakeapi.Fault{Path: domainPath, Code: "DOMAIN_NOT_FOUND", Message: "Data domain not found"})
+
+		_, err := apiClient.GetDomain(ctx, domainUUID)
+		assert.True(t, IsNotFound(err), "Error envelope should be classified, got %v", err)
+
+		server.InjectFault(fakeapi.Fault{Path: "/clientApi/data-domain/list", Nth: 3, Code: "INTERNAL_ERROR"})
+		domains, err := apiClient.ListDomains(ctx)
+		var apiErr *APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "INTERNAL_ERROR", apiErr.Code)
+		assert.Nil(t, domains)
+	})
+
+	t.Run("lying pagination total", func(t *testing.T) {
+		server, apiClient := newFaultClient(t)
+		server.InjectFault(fakeapi.Fault{Path: "/clientApi/data-domain/list", Times: -1, TotalOffset: 10})
+
+		domains, err := apiClient.ListDomains(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, domains, 5, "A total that is too high should stop on the first empty page")
+		assert.Equal(t, 4, server.Requests())
+
+		server.ClearFaults()
+		server.InjectFault(fakeapi.Fault{Path: "/clientApi/data-domain/list", Times: -1, TotalOffset: -4})
+		domains, err = apiClient.ListDomains(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, domains, 5, "A total that is too low should not drop items")
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("7")
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute.Seconds(), wait.Seconds(), 2)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
+
+// TestRetryWait ensures backoff waits stay within the configured bounds
+func TestRetryWait(t *testing.T) {
+	c := &Client{RetryMinWait: time.Second, RetryMaxWait: 4 * time.Second}
+	assert.GreaterOrEqual(t, c.retryWait(0, nil), 500*time.Millisecond)
+	assert.Less(t, c.retryWait(0, nil), time.Second)
+	assert.GreaterOrEqual(t, c.retryWait(10, nil), 2*time.Second)
+	assert.Less(t, c.retryWait(10, nil), 4*time.Second)
+	assert.GreaterOrEqual(t, c.retryWait(100, nil), 2*time.Second, "An overflowing backoff should use RetryMaxWait")
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	assert.Equal(t, 4*time.Second, c.retryWait(0, res), "Retry-After should be capped by RetryMaxWait")
+	res.Header.Set("Retry-After", "2")
+	assert.Equal(t, 2*time.Second, c.retryWait(0, res))
+
+	c.RetryMinWait = 0
+	assert.Equal(t, time.Duration(0), c.retryWait(0, nil), "A RetryMinWait of 0 should retry immediately")
+	assert.Equal(t, time.Duration(0), c.retryWait(5, nil))
+}
+
+// TestAPIError ensures HTTP errors and error envelopes decode into a classified *APIError
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/clientApi/data-domain/missing":
+			w.Header().Set(RequestIDHeader, "req-123")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"NOT_FOUND","message":"Data domain not found"}`)
+		case "/clientApi/data-domain/conflict":
+			fmt.Fprint(w, `{"value":null,"error":{"code":"DOMAIN_ALREADY_EXISTS","message":"Domain exists"}}`)
+		case "/clientApi/data-domain/denied":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `Unauthorized`)
+		}
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithRetry(0, 0, 0))
+	assert.NoError(t, err)
+	apiClient.HostURL = server.URL
+
+	_, err = apiClient.GetDomain(context.Background(), "missing")
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, &APIError{StatusCode: 404, Code: "NOT_FOUND", Message: "Data domain not found", RequestID: "req-123"}, apiErr)
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsConflict(err))
+
+	_, err = apiClient.GetDomain(context.Background(), "conflict")
+	assert.True(t, IsConflict(err), "Error envelopes in 200 responses should be classified by code")
+	assert.EqualError(t, err, "status: 200, code: DOMAIN_ALREADY_EXISTS, message: Domain exists")
+
+	_, err = apiClient.GetDomain(context.Background(), "denied")
+	assert.True(t, IsUnauthorized(err))
+	assert.False(t, IsNotFound(err))
+}
+
+// TestClientCustomCA ensures a private CA bundle is trusted by the client transport
+func TestClientCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value":{"uuid":"d1","name":"Domain"}}`)
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	untrusted, err := NewClient(&token, WithHostURL(server.URL), WithRetry(0, 0, 0))
+	assert.NoError(t, err)
+	_, err = untrusted.GetDomain(context.Background(), "d1")
+	assert.Error(t, err, "Self-signed server certificate should not be trusted by default")
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err := NewTransport(TLSOptions{CACertPEM: caCertPEM})
+	assert.NoError(t, err)
+
+	trusted, err := NewClient(&token, WithHostURL(server.URL), WithTransport(transport), WithTimeout(time.Second))
+	assert.NoError(t, err)
+	domain, err := trusted.GetDomain(context.Background(), "d1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Domain", domain.Name)
+
+	_, err = NewTransport(TLSOptions{CACertPEM: []byte("not a certificate")})
+	assert.Error(t, err)
+	_, err = NewTransport(TLSOptions{ClientCertPEM: caCertPEM})
+	assert.Error(t, err, "Client certificate without a key should be rejected")
+}
+
+// TestPaginate ensures the paginator stops on the reported total, on empty pages, on a runaway total and on early break
+func TestPaginate(t *testing.T) {
+	ctx := context.Background()
+	pages := 0
+	fetch := func(total int) PageFetcher[int] {
+		return func(ctx context.Context, page, pageSize int) ([]int, Pagination, error) {
+			pages++
+			items := make([]int, 0, pageSize)
+			for i := (page - 1) * pageSize; i < page*pageSize && i < 5; i++ {
+				items = append(items, i)
+			}
+			return items, Pagination{Total: total, Page: page}, nil
+		}
+	}
+
+	items, err := collect(Paginate(ctx, fetch(5), PageOptions{PageSize: 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, items)
+	assert.Equal(t, 3, pages)
+
+	// Total larger than the real item count stops on the first empty page
+	pages = 0
+	items, err = collect(Paginate(ctx, fetch(50), PageOptions{PageSize: 2}))
+	assert.NoError(t, err)
+	assert.Len(t, items, 5)
+	assert.Equal(t, 4, pages)
+
+	// A fetcher that never runs out of items is stopped by the page limit
+	endless := func(ctx context.Context, page, pageSize int) ([]int, Pagination, error) {
+		return []int{page}, Pagination{Total: 1 << 30}, nil
+	}
+	_, err = collect(Paginate(ctx, endless, PageOptions{PageSize: 1, MaxPages: 10}))
+	assert.ErrorContains(t, err, "pagination stopped after 10 pages")
+
+	// Breaking out of the loop does not fetch further pages
+	pages = 0
+	for item, err := range Paginate(ctx, fetch(5), PageOptions{PageSize: 2}) {
+		assert.NoError(t, err)
+		if item == 1 {
+			break
+		}
+	}
+	assert.Equal(t, 1, pages)
+}
+
+// TestListUsers ensures users are paged through with filters and API errors are surfaced
+func TestListUsers(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if r.URL.Query().Get("role") == "USER" {
+			fmt.Fprint(w, `{"values":[],"error":"FORBIDDEN"}`)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"values":[{"email":"a@example.com","role":"OWNER"},{"email":"b@example.com","role":"OWNER"}],"pagination":{"total":3,"page":1}}`)
+		case "2":
+			fmt.Fprint(w, `{"values":[{"email":"c@example.com","role":"OWNER"}],"pagination":{"total":3,"page":2}}`)
+		}
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithHostURL(server.URL), WithPageSize(2))
+	assert.NoError(t, err)
+
+	users, err := apiClient.ListUsers(context.Background(), UserFilter{Role: UserRoleOwner})
+	assert.NoError(t, err)
+	assert.Len(t, users, 3, "Users past the first page should be returned")
+	assert.Equal(t, []string{"limit=2&page=1&role=OWNER", "limit=2&page=2&role=OWNER"}, queries)
+
+	users, err = apiClient.ListUsers(context.Background(), UserFilter{Role: UserRoleUser})
+	assert.True(t, IsForbidden(err), "Error envelope should be surfaced")
+	assert.Nil(t, users)
+}
+
+// TestListUsersCache ensures concurrent and repeated reads share one API call until a user changes
+func TestListUsersCache(t *testing.T) {
+	var listCalls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/clientApi/user/list" {
+			listCalls.Add(1)
+			<-release
+		}
+		fmt.Fprint(w, `{"values":[{"email":"a@example.com","role":"OWNER"}],"value":{"email":"a@example.com","role":"USER"}}`)
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithHostURL(server.URL))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			users, err := apiClient.ListUsers(context.Background(), UserFilter{})
+			assert.NoError(t, err)
+			assert.Len(t, users, 1)
+		})
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), listCalls.Load(), "Concurrent reads should collapse into one call")
+
+	_, err = apiClient.ListUsers(context.Background(), UserFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), listCalls.Load(), "Reads within the TTL should use the snapshot")
+
+	_, err = apiClient.UpdateUserRole(context.Background(), User{Email: "a@example.com", Role: UserRoleUser})
+	assert.NoError(t, err)
+	_, err = apiClient.ListUsers(context.Background(), UserFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), listCalls.Load(), "Changing a user should invalidate the snapshot")
+}
+
+// TestLimiter ensures the number of in-flight requests is bounded and limiters are shared per token
+func TestLimiter(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{"value":{"uuid":"d1"}}`)
+	}))
+	defer server.Close()
+
+	token := "test-token"
+	apiClient, err := NewClient(&token, WithHostURL(server.URL), WithLimiter(NewLimiter(2, 0)))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			_, err := apiClient.GetDomain(context.Background(), "d1")
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+// TestClientLogging ensures requests are logged in the client subsystem with secrets redacted
+func TestClientLogging(t *testing.T) {
+	t.Setenv(LogLevelEnvVar, "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value":{"uuid":"d1","name":"Domain"},"apiToken":"server-secret"}`)
+	}))
+	defer server.Close()
+
+	token := "super-secret-token"
+	apiClient, err := NewClient(&token, WithHostURL(server.URL))
+	assert.NoError(t, err)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	_, err = apiClient.UpdateDomain(ctx, DataDomain{UUID: "d1", Name: "Domain"})
+	assert.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, entries)
+	for _, entry := range entries {
+		assert.Equal(t, "provider."+LogSubsystem, entry["@module"])
+	}
+	logs := fmt.Sprint(entries)
+	assert.Contains(t, logs, "/clientApi/data-domain/d1")
+	assert.Contains(t, logs, "status:200")
+	assert.Contains(t, logs, "latency_ms")
+	assert.NotContains(t, logs, token)
+	assert.NotContains(t, logs, "server-secret")
+}
@@ -0,0 +1,190 @@
+package masthead
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTokenCommandTTL - How long the output of a token command is reused before running it again
+const DefaultTokenCommandTTL = 5 * time.Minute
+
+// TokenSource provides the API token sent with each request, for tokens that
+// are not known when the client is created or that rotate, e.g. tokens written
+// by a Vault agent or printed by a credential helper.
+type TokenSource interface {
+	// Token returns the current API token
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards any cached token, after the API rejected it
+	Invalidate()
+}
+
+// WithTokenSource sets where the API token is read from before each request,
+// instead of the static token given to NewClient
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) {
+		c.tokens = source
+	}
+}
+
+// FileTokenSource reads the API token from a file, and reads it again whenever
+// the file changes. Surrounding whitespace is ignored.
+type FileTokenSource struct {
+	Path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileTokenSource returns a token source reading the file at path
+func NewFileTokenSource(path string) *FileTokenSource {
+	return &FileTokenSource{Path: path}
+}
+
+// Token returns the token in the file, reading it again if the file was modified
+func (s *FileTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read API token file: %w", err)
+	}
+	if s.token != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read API token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", s.Path)
+	}
+
+	s.token, s.modTime, s.size = token, info.ModTime(), info.Size()
+	return s.token, nil
+}
+
+// Invalidate forces the file to be read again on the next call to Token
+func (s *FileTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+}
+
+// CommandTokenSource runs a credential helper command that prints the API
+// token on its standard output. The token is reused for TTL, or until the API
+// rejects it.
+type CommandTokenSource struct {
+	Command []string
+	TTL     time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	now       func() time.Time
+}
+
+// NewCommandTokenSource returns a token source running command, its first
+// element being the program and the others its arguments. A ttl of zero
+// uses DefaultTokenCommandTTL.
+func NewCommandTokenSource(command []string, ttl time.Duration) *CommandTokenSource {
+	if ttl <= 0 {
+		ttl = DefaultTokenCommandTTL
+	}
+	return &CommandTokenSource{Command: command, TTL: ttl, now: time.Now}
+}
+
+// Token returns the cached token, running the command again once it expired
+func (s *CommandTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.now().Before(s.expiresAt) {
+		return s.token, nil
+	}
+	if len(s.Command) == 0 {
+		return "", fmt.Errorf("API token command is empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.Command[0], s.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// The standard output may hold a secret, only report the error output
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("API token command %q failed: %w: %s", s.Command[0], err, message)
+		}
+		return "", fmt.Errorf("API token command %q failed: %w", s.Command[0], err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("API token command %q printed no token", s.Command[0])
+	}
+
+	s.token, s.expiresAt = token, s.now().Add(s.TTL)
+	return s.token, nil
+}
+
+// Invalidate forces the command to run again on the next call to Token
+func (s *CommandTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = ""
+}
+
+// token returns the API token to send with the next request
+func (c *Client) token(ctx context.Context) (string, error) {
+	if c.tokens == nil {
+		return c.Token, nil
+	}
+	return c.tokens.Token(ctx)
+}
+
+// CurrentToken - Returns the description of the API token used by the client.
+// The endpoint is implemented by the fake API but not confirmed on the live API.
+func (c *Client) CurrentToken(ctx context.Context) (*TokenInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/clientApi/token/current", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInfoResponse := TokenInfoResponse{}
+	err = json.Unmarshal(body, &tokenInfoResponse)
+	if err != nil {
+		return nil, err
+	} else if err := tokenInfoResponse.Err(); err != nil {
+		return nil, err
+	}
+
+	return &tokenInfoResponse.TokenInfo, nil
+}
+
+// ValidateCredentials sends a lightweight authenticated request, listing a
+// single user, to check that the API token is accepted. The token endpoint is
+// not used, as it is not confirmed on the live API. A rejected token is
+// reported as an error matching IsUnauthorized or IsForbidden.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	_, _, err := c.listUsersPage(ctx, UserFilter{}, 1, 1)
+	return err
+}
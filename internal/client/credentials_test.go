@@ -0,0 +1,174 @@
+package masthead
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+// TestFileTokenSource ensures the token file is read again when it changes
+func TestFileTokenSource(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	source := NewFileTokenSource(path)
+
+	_, err := source.Token(ctx)
+	assert.ErrorContains(t, err, "unable to read API token file")
+
+	assert.NoError(t, os.WriteFile(path, []byte("first-token\n"), 0o600))
+	token, err := source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "first-token", token)
+
+	// Vault agent sinks replace the file when the token is renewed
+	assert.NoError(t, os.WriteFile(path, []byte("second-token-renewed\n"), 0o600))
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "second-token-renewed", token)
+
+	assert.NoError(t, os.WriteFile(path, []byte("  \n"), 0o600))
+	_, err = source.Token(ctx)
+	assert.ErrorContains(t, err, "is empty")
+}
+
+// TestCommandTokenSource ensures the command output is cached until it expires or is invalidated
+func TestCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command requires a POSIX shell")
+	}
+
+	ctx := context.Background()
+	counter := filepath.Join(t.TempDir(), "runs")
+	source := NewCommandTokenSource([]string{"sh", "-c", `echo x >> "$0"; echo "token-$(wc -l < "$0" | tr -d ' ')"`, counter}, time.Minute)
+	now := time.Now()
+	source.now = func() time.Time { return now }
+
+	token, err := source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token, "Token should be cached")
+
+	now = now.Add(2 * time.Minute)
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token, "Expired token should be fetched again")
+
+	source.Invalidate()
+	token, err = source.Token(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-3", token, "Invalidated token should be fetched again")
+
+	failing := NewCommandTokenSource([]string{"sh", "-c", "echo secret-output; echo 'not logged in' >&2; exit 3"}, 0)
+	_, err = failing.Token(ctx)
+	assert.ErrorContains(t, err, "not logged in")
+	assert.NotContains(t, err.Error(), "secret-output", "Standard output may hold a secret")
+
+	_, err = NewCommandTokenSource([]string{"sh", "-c", "true"}, 0).Token(ctx)
+	assert.ErrorContains(t, err, "printed no token")
+}
+
+// TestClientTokenRefresh ensures a rotated token is read again once after the API rejects it
+func TestClientTokenRefresh(t *testing.T) {
+	server := fakeapi.New()
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(path, []byte(server.Token), 0o600))
+	apiClient, err := NewClient(nil, WithHostURL(server.URL), WithTokenSource(NewFileTokenSource(path)))
+	assert.NoError(t, err)
+
+	_, err = apiClient.ListDomains(context.Background())
+	assert.NoError(t, err)
+
+	// Rotate the token on both sides, keeping the file metadata unchanged
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	server.Token = "rotated-token!"
+	assert.NoError(t, os.WriteFile(path, []byte(server.Token), 0o600))
+	assert.NoError(t, os.Chtimes(path, info.ModTime(), info.ModTime()))
+
+	_, err = apiClient.CreateDomain(context.Background(), DataDomain{Name: "Sales", Email: "sales@example.com"})
+	assert.NoError(t, err, "Request should be sent again with the new token")
+
+	server.Token = "revoked"
+	_, err = apiClient.ListDomains(context.Background())
+	assert.True(t, IsUnauthorized(err), "Token should only be read again once, got %v", err)
+}
+
+func TestNewClientRequiresToken(t *testing.T) {
+	t.Setenv(TokenEnvVar, "from-env")
+	_, err := NewClient(nil)
+	assert.Error(t, err, "NewClient should not read the environment")
+
+	empty := ""
+	_, err = NewClient(&empty)
+	assert.Error(t, err)
+}
+
+func TestValidateCredentials(t *testing.T) {
+	server := fakeapi.New()
+	defer server.Close()
+
+	client, err := NewClient(&server.Token, WithHostURL(server.URL))
+	assert.NoError(t, err)
+	assert.NoError(t, client.ValidateCredentials(context.Background()))
+
+	revoked := "revoked-token"
+	client, err = NewClient(&revoked, WithHostURL(server.URL))
+	assert.NoError(t, err)
+	err = client.ValidateCredentials(context.Background())
+	assert.True(t, IsUnauthorized(err), "A rejected token should be unauthorized, got %v", err)
+
+	// The unconfirmed token endpoint is never used
+	server.InjectFault(fakeapi.Fault{Path: "/clientApi/token/current", Status: http.StatusMethodNotAllowed, Times: -1})
+	client, err = NewClient(&server.Token, WithHostURL(server.URL))
+	assert.NoError(t, err)
+	assert.NoError(t, client.ValidateCredentials(context.Background()))
+
+	server.InjectFault(fakeapi.Fault{Path: "/clientApi/user/list", Status: http.StatusForbidden, Code: "FORBIDDEN"})
+	err = client.ValidateCredentials(context.Background())
+	assert.True(t, IsForbidden(err), "A token without access should be forbidden, got %v", err)
+}
+
+func TestCurrentToken(t *testing.T) {
+	server := fakeapi.New()
+	defer server.Close()
+
+	client, err := NewClient(&server.Token, WithHostURL(server.URL))
+	assert.NoError(t, err)
+
+	info, err := client.CurrentToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "fake-org", info.Organization.ID)
+	assert.Equal(t, UserRoleOwner, info.Role)
+	assert.Nil(t, info.ExpiresAt)
+
+	expiresAt := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	server.SetTokenInfo(fakeapi.TokenInfo{
+		Name:         "ci",
+		Organization: fakeapi.Organization{ID: "org-1", Name: "Acme"},
+		Role:         "USER",
+		Scopes:       []string{"users:read"},
+		ExpiresAt:    &expiresAt,
+	})
+	info, err = client.CurrentToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &TokenInfo{
+		Name:         "ci",
+		Organization: Organization{ID: "org-1", Name: "Acme"},
+		Role:         UserRoleUser,
+		Scopes:       []string{"users:read"},
+		ExpiresAt:    &expiresAt,
+	}, info)
+}
@@ -1,57 +1,56 @@
 package masthead
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
 
 // ListDomains - Returns list of all data domains with pagination support
-func (c *Client) ListDomains() ([]DataDomain, error) {
-	var allDataDomains []DataDomain
-	page := 1
-
-	for {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/clientApi/data-domain/list?page=%d",
-			c.HostURL, page), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		body, err := c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-
-		dataDomainsResponse := DomainListResponse{}
-		err = json.Unmarshal(body, &dataDomainsResponse)
-		if err != nil {
-			return nil, err
-		} else if dataDomainsResponse.Error != nil {
-			return nil, fmt.Errorf("error: %v. %v", dataDomainsResponse.Error, dataDomainsResponse.Message)
-		}
-
-		allDataDomains = append(allDataDomains, dataDomainsResponse.DataDomains...)
-
-		// Break if we've retrieved all pages
-		if len(dataDomainsResponse.DataDomains) == 0 || len(allDataDomains) >= dataDomainsResponse.Pagination.Total {
-			break
-		}
-		page++
-	}
-
-	return allDataDomains, nil
+func (c *Client) ListDomains(ctx context.Context) ([]DataDomain, error) {
+	return collect(c.IterDomains(ctx))
+}
+
+// IterDomains - Streams all data domains, fetching pages as they are consumed
+func (c *Client) IterDomains(ctx context.Context) iter.Seq2[DataDomain, error] {
+	return Paginate(ctx, c.listDomainsPage, PageOptions{PageSize: c.PageSize})
+}
+
+// listDomainsPage - Returns a single page of data domains
+func (c *Client) listDomainsPage(ctx context.Context, page, pageSize int) ([]DataDomain, Pagination, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/clientApi/data-domain/list?page=%d&limit=%d",
+		c.HostURL, page, pageSize), nil)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	dataDomainsResponse := DomainListResponse{}
+	err = json.Unmarshal(body, &dataDomainsResponse)
+	if err != nil {
+		return nil, Pagination{}, err
+	} else if err := dataDomainsResponse.Err(); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return dataDomainsResponse.DataDomains, dataDomainsResponse.Pagination, nil
 }
 
 // CreateDomain - Create a new data domain in the system
-func (c *Client) CreateDomain(dataDomain DataDomain) (*DataDomain, error) {
+func (c *Client) CreateDomain(ctx context.Context, dataDomain DataDomain) (*DataDomain, error) {
 	rb, err := json.Marshal(dataDomain)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST",
+	req, err := http.NewRequestWithContext(ctx, "POST",
 		fmt.Sprintf("%s/clientApi/data-domain", c.HostURL),
 		strings.NewReader(string(rb)))
 	if err != nil {
@@ -67,16 +66,16 @@ func (c *Client) CreateDomain(dataDomain DataDomain) (*DataDomain, error) {
 	err = json.Unmarshal(body, &dataDomainResponse)
 	if err != nil {
 		return nil, err
-	} else if dataDomainResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", dataDomainResponse.Error, dataDomainResponse.Message)
+	} else if err := dataDomainResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &dataDomainResponse.DataDomain, nil
 }
 
 // GetDomain - Get a specific data domain by ID
-func (c *Client) GetDomain(dataDomainID string) (*DataDomain, error) {
-	req, err := http.NewRequest("GET",
+func (c *Client) GetDomain(ctx context.Context, dataDomainID string) (*DataDomain, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET",
 		fmt.Sprintf("%s/clientApi/data-domain/%s", c.HostURL, dataDomainID),
 		nil,
 	)
@@ -93,15 +92,15 @@ func (c *Client) GetDomain(dataDomainID string) (*DataDomain, error) {
 	err = json.Unmarshal(body, &dataDomainResponse)
 	if err != nil {
 		return nil, err
-	} else if dataDomainResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", dataDomainResponse.Error, dataDomainResponse.Message)
+	} else if err := dataDomainResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &dataDomainResponse.DataDomain, nil
 }
 
 // UpdateDomain - Update an existing data domain
-func (c *Client) UpdateDomain(dataDomain DataDomain) (*DataDomain, error) {
+func (c *Client) UpdateDomain(ctx context.Context, dataDomain DataDomain) (*DataDomain, error) {
 	if dataDomain.UUID == "" {
 		return nil, fmt.Errorf("domain UUID cannot be empty")
 	}
@@ -110,7 +109,7 @@ func (c *Client) UpdateDomain(dataDomain DataDomain) (*DataDomain, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT",
+	req, err := http.NewRequestWithContext(ctx, "PUT",
 		fmt.Sprintf("%s/clientApi/data-domain/%s", c.HostURL, dataDomain.UUID),
 		strings.NewReader(string(rb)),
 	)
@@ -128,22 +127,26 @@ func (c *Client) UpdateDomain(dataDomain DataDomain) (*DataDomain, error) {
 	if err != nil {
 		return nil, err
 	}
-	if domainResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", domainResponse.Error, domainResponse.Message)
+	if err := domainResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &domainResponse.DataDomain, nil
 }
 
 // DeleteDomain - Remove a data domain from the system by ID
-func (c *Client) DeleteDomain(domainID string) error {
-	req, err := http.NewRequest("DELETE",
+func (c *Client) DeleteDomain(ctx context.Context, domainID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE",
 		fmt.Sprintf("%s/clientApi/data-domain/%s", c.HostURL, domainID),
 		nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.doRequest(req)
-	return err
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return checkResponseStatus(body)
 }
@@ -1,62 +1,57 @@
 package masthead
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"strings"
 )
 
 // ListDataProducts - Returns list of all data products with pagination
-func (c *Client) ListDataProducts() ([]DataProduct, error) {
-	var allProducts []DataProduct
-	page := 1
-	morePages := true
-
-	for morePages {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/clientApi/data-product/list?page=%d&limit=100",
-			c.HostURL, page), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		body, err := c.doRequest(req)
-		if err != nil {
-			return nil, err
-		}
-
-		productsResponse := DataProductListResponse{}
-		err = json.Unmarshal(body, &productsResponse)
-		if err != nil {
-			return nil, err
-		} else if productsResponse.Error != nil {
-			return nil, fmt.Errorf("error: %v. %v", productsResponse.Error, productsResponse.Message)
-		}
-
-		allProducts = append(allProducts, productsResponse.DataProducts...)
-
-		// Check if we've fetched all pages
-		totalItems := productsResponse.Pagination.Total
-		itemsFetched := len(allProducts)
-		if itemsFetched >= totalItems {
-			morePages = false
-		} else {
-			page++
-		}
-	}
-
-	return allProducts, nil
+func (c *Client) ListDataProducts(ctx context.Context) ([]DataProduct, error) {
+	return collect(c.IterDataProducts(ctx))
+}
+
+// IterDataProducts - Streams all data products, fetching pages as they are consumed
+func (c *Client) IterDataProducts(ctx context.Context) iter.Seq2[DataProduct, error] {
+	return Paginate(ctx, c.listDataProductsPage, PageOptions{PageSize: c.PageSize})
+}
+
+// listDataProductsPage - Returns a single page of data products
+func (c *Client) listDataProductsPage(ctx context.Context, page, pageSize int) ([]DataProduct, Pagination, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/clientApi/data-product/list?page=%d&limit=%d",
+		c.HostURL, page, pageSize), nil)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	productsResponse := DataProductListResponse{}
+	err = json.Unmarshal(body, &productsResponse)
+	if err != nil {
+		return nil, Pagination{}, err
+	} else if err := productsResponse.Err(); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return productsResponse.DataProducts, productsResponse.Pagination, nil
 }
 
 // CreateDataProduct - Create a new data product in the system
-func (c *Client) CreateDataProduct(dataProduct DataProduct) (*DataProduct, error) {
+func (c *Client) CreateDataProduct(ctx context.Context, dataProduct DataProduct) (*DataProduct, error) {
 
 	rb, err := json.Marshal(dataProduct)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST",
+	req, err := http.NewRequestWithContext(ctx, "POST",
 		fmt.Sprintf("%s/clientApi/data-product", c.HostURL),
 		strings.NewReader(string(rb)))
 	if err != nil {
@@ -72,16 +67,16 @@ func (c *Client) CreateDataProduct(dataProduct DataProduct) (*DataProduct, error
 	err = json.Unmarshal(body, productResponse)
 	if err != nil {
 		return nil, err
-	} else if productResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", productResponse.Error, productResponse.Message)
+	} else if err := productResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &productResponse.DataProduct, nil
 }
 
 // GetDataProduct - Get a specific data product by ID
-func (c *Client) GetDataProduct(productID string) (*DataProduct, error) {
-	req, err := http.NewRequest("GET",
+func (c *Client) GetDataProduct(ctx context.Context, productID string) (*DataProduct, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET",
 		fmt.Sprintf("%s/clientApi/data-product/%s", c.HostURL, productID),
 		nil)
 	if err != nil {
@@ -95,9 +90,9 @@ func (c *Client) GetDataProduct(productID string) (*DataProduct, error) {
 
 	productResponse := &DataProductResponse{}
 	err = json.Unmarshal(body, productResponse)
-	if productResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", productResponse.Error, productResponse.Message)
-	} else if err != nil {
+	if err != nil {
+		return nil, err
+	} else if err := productResponse.Err(); err != nil {
 		return nil, err
 	}
 
@@ -105,13 +100,13 @@ func (c *Client) GetDataProduct(productID string) (*DataProduct, error) {
 }
 
 // UpdateDataProduct - Update an existing data product
-func (c *Client) UpdateDataProduct(dataProduct DataProduct) (*DataProduct, error) {
+func (c *Client) UpdateDataProduct(ctx context.Context, dataProduct DataProduct) (*DataProduct, error) {
 	rb, err := json.Marshal(dataProduct)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT",
+	req, err := http.NewRequestWithContext(ctx, "PUT",
 		fmt.Sprintf("%s/clientApi/data-product/%s", c.HostURL, dataProduct.UUID),
 		strings.NewReader(string(rb)))
 	if err != nil {
@@ -125,9 +120,9 @@ func (c *Client) UpdateDataProduct(dataProduct DataProduct) (*DataProduct, error
 
 	productResponse := &DataProductResponse{}
 	err = json.Unmarshal(body, productResponse)
-	if productResponse.Error != nil {
-		return nil, fmt.Errorf("error: %v. %v", productResponse.Error, productResponse.Message)
-	} else if err != nil {
+	if err != nil {
+		return nil, err
+	} else if err := productResponse.Err(); err != nil {
 		return nil, err
 	}
 
@@ -135,14 +130,18 @@ func (c *Client) UpdateDataProduct(dataProduct DataProduct) (*DataProduct, error
 }
 
 // DeleteDataProduct - Remove a data product from the system by ID
-func (c *Client) DeleteDataProduct(productID string) error {
-	req, err := http.NewRequest("DELETE",
+func (c *Client) DeleteDataProduct(ctx context.Context, productID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE",
 		fmt.Sprintf("%s/clientApi/data-product/%s", c.HostURL, productID),
 		nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.doRequest(req)
-	return err
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return checkResponseStatus(body)
 }
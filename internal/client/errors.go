@@ -0,0 +1,169 @@
+package masthead
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestIDHeader - Response header carrying the API request ID
+const RequestIDHeader string = "X-Request-Id"
+
+// APIError represents an error returned by the Masthead API, either as a
+// non-200 HTTP response or as an error envelope in a 200 response.
+type APIError struct {
+	StatusCode int    // HTTP status code of the response
+	Code       string // Error code reported by the API, if any
+	Message    string // Human readable error message
+	RequestID  string // Request ID to quote when contacting Masthead support
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "status: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&sb, ", code: %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ", message: %s", e.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, ", request ID: %s", e.RequestID)
+	}
+	return sb.String()
+}
+
+// hasCode reports whether the API error code ends with any of the given suffixes,
+// so that both generic ("NOT_FOUND") and specific ("DOMAIN_NOT_FOUND") codes match.
+func (e *APIError) hasCode(suffixes ...string) bool {
+	code := strings.ToUpper(e.Code)
+	for _, suffix := range suffixes {
+		if code != "" && strings.HasSuffix(code, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether the error means the requested object does not exist
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusNotFound || apiErr.hasCode("NOT_FOUND"))
+}
+
+// IsConflict reports whether the error means the object conflicts with an existing one
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusConflict || apiErr.hasCode("CONFLICT", "ALREADY_EXISTS"))
+}
+
+// IsUnauthorized reports whether the error means the API token is missing, invalid or expired
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusUnauthorized || apiErr.hasCode("UNAUTHORIZED", "UNAUTHENTICATED"))
+}
+
+// IsForbidden reports whether the error means the API token lacks permission for the operation
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusForbidden || apiErr.hasCode("FORBIDDEN", "ACCESS_DENIED"))
+}
+
+// ErrorBody represents the error field of an API response envelope.
+// The API reports errors either as an object or as a plain string.
+type ErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ErrorBody) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case len(data) > 0 && data[0] == '"':
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		// A single word is an error code, anything else is a message
+		if strings.ContainsAny(value, " \t\n") {
+			e.Message = value
+		} else {
+			e.Code = value
+		}
+	case len(data) > 0 && data[0] == '{':
+		type errorBody ErrorBody
+		if err := json.Unmarshal(data, (*errorBody)(e)); err != nil {
+			return err
+		}
+	default:
+		e.Message = string(data)
+	}
+
+	return nil
+}
+
+// ResponseStatus holds the error fields shared by every API response envelope
+type ResponseStatus struct {
+	Error     *ErrorBody `json:"error,omitempty"`
+	Message   string     `json:"message,omitempty"`
+	RequestID string     `json:"requestId,omitempty"`
+}
+
+// Err returns the envelope error as an *APIError, or nil if the response
+// does not report an error.
+func (s ResponseStatus) Err() error {
+	if s.Error == nil {
+		return nil
+	}
+	return s.apiError(http.StatusOK, "")
+}
+
+func (s ResponseStatus) apiError(statusCode int, requestID string) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Message:    s.Message,
+		RequestID:  s.RequestID,
+	}
+	if s.Error != nil {
+		apiErr.Code = s.Error.Code
+		if apiErr.Message == "" {
+			apiErr.Message = s.Error.Message
+		}
+	}
+	if requestID != "" {
+		apiErr.RequestID = requestID
+	}
+	return apiErr
+}
+
+// checkResponseStatus returns the envelope error of a response whose value
+// is not needed, such as a delete. Empty or non-JSON bodies are not errors.
+func checkResponseStatus(body []byte) error {
+	var status ResponseStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil
+	}
+	return status.Err()
+}
+
+// newAPIError builds an *APIError from a non-200 HTTP response
+func newAPIError(res *http.Response, body []byte) *APIError {
+	var status ResponseStatus
+	if err := json.Unmarshal(body, &status); err == nil && (status.Error != nil || status.Message != "") {
+		return status.apiError(res.StatusCode, res.Header.Get(RequestIDHeader))
+	}
+
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RequestID:  res.Header.Get(RequestIDHeader),
+	}
+}
@@ -0,0 +1,62 @@
+package masthead
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// Limiter bounds the number of in-flight API requests and the request rate.
+// It is safe for concurrent use.
+type Limiter struct {
+	slots chan struct{} // nil when concurrency is not limited
+	rate  *rate.Limiter // nil when the rate is not limited
+}
+
+// NewLimiter creates a limiter allowing maxConcurrent requests in flight and
+// requestsPerSecond requests on average. Zero disables the respective limit.
+func NewLimiter(maxConcurrent int, requestsPerSecond float64) *Limiter {
+	l := &Limiter{}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		// Allow short bursts of up to one second worth of requests
+		burst := max(1, int(math.Ceil(requestsPerSecond)))
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent. The returned function must be
+// called once the response has been read.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// WithLimiter sets the limiter applied to every API request, including retries
+func WithLimiter(limiter *Limiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
@@ -0,0 +1,110 @@
+package masthead
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem - Name of the tflog subsystem used for API request logs
+const LogSubsystem string = "masthead_client"
+
+// LogLevelEnvVar - Environment variable setting the level of API request logs
+const LogLevelEnvVar string = "TF_LOG_PROVIDER_MASTHEAD_CLIENT"
+
+// redactedValue replaces secrets in logged headers
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders are never logged with their value
+var sensitiveHeaders = map[string]bool{
+	"X-Api-Token":   true,
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+}
+
+// sensitiveJSONValues matches values of secret looking keys in logged JSON bodies
+var sensitiveJSONValues = regexp.MustCompile(`(?i)("[a-z_]*(?:token|secret|password|key)"\s*:\s*)"[^"]*"`)
+
+// logContext returns a context carrying the client log subsystem, with the
+// API token masked in every message and field.
+func (c *Client) logContext(ctx context.Context, token string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(LogLevelEnvVar))
+	if token != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, token)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, token)
+	}
+	return ctx
+}
+
+// logRequest logs an outgoing API request, with headers and body at TRACE level
+func logRequest(ctx context.Context, req *http.Request, attempt int) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending Masthead API request", map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"attempt": attempt + 1,
+	})
+
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"headers": redactHeaders(req.Header),
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			fields["body"] = redactBody(data)
+		}
+	}
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Masthead API request details", fields)
+}
+
+// logResponse logs an API response with its latency, and its body at TRACE level
+func logResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, latency time.Duration) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received Masthead API response", map[string]interface{}{
+		"method":     req.Method,
+		"path":       req.URL.RequestURI(),
+		"status":     res.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"request_id": res.Header.Get(RequestIDHeader),
+	})
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Masthead API response details", map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"headers": redactHeaders(res.Header),
+		"body":    redactBody(body),
+	})
+}
+
+// logRetry logs why and when a failed API request is retried
+func logRetry(ctx context.Context, req *http.Request, reason string, wait time.Duration) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, "Retrying Masthead API request", map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"reason":  reason,
+		"wait_ms": wait.Milliseconds(),
+	})
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			redacted[name] = redactedValue
+		} else {
+			redacted[name] = strings.Join(values, ", ")
+		}
+	}
+	return redacted
+}
+
+func redactBody(body []byte) string {
+	return sensitiveJSONValues.ReplaceAllString(string(body), `$1"`+redactedValue+`"`)
+}
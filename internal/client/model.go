@@ -23,14 +23,45 @@ type User struct {
 type UserResponse struct {
 	User  User        `json:"value"`
 	Extra interface{} `json:"extra"`
-	Error interface{} `json:"error"`
+	ResponseStatus
 }
 
 // UsersResponse represents the response from the list users API
 type UsersResponse struct {
-	Users []User      `json:"values"`
-	Extra interface{} `json:"extra"`
-	Error interface{} `json:"error"`
+	Users      []User      `json:"values"`
+	Pagination Pagination  `json:"pagination"`
+	Extra      interface{} `json:"extra"`
+	ResponseStatus
+}
+
+// UserFilter narrows down the users returned by the list users API.
+// Empty fields are not filtered on. The live API is not confirmed to
+// support these filters, so callers should check the returned users.
+type UserFilter struct {
+	Email string
+	Role  UserRole
+}
+
+// Organization represents the Masthead account owning an API token
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TokenInfo describes the API token used to authenticate requests
+type TokenInfo struct {
+	Name         string       `json:"name"`
+	Organization Organization `json:"organization"`
+	Role         UserRole     `json:"role"`
+	Scopes       []string     `json:"scopes"`
+	ExpiresAt    *time.Time   `json:"expiresAt"` // nil if the token does not expire
+}
+
+// TokenInfoResponse represents the response from the current token API
+type TokenInfoResponse struct {
+	TokenInfo TokenInfo   `json:"value"`
+	Extra     interface{} `json:"extra"`
+	ResponseStatus
 }
 
 // Pagination represents pagination details in API responses
@@ -57,9 +88,9 @@ type DataDomain struct {
 
 // DomainResponse represents the response from the create/update domain API
 type DomainResponse struct {
-	DataDomain DataDomain `json:"value"`
-	Error      error      `json:"error,omitempty"`
-	Message    string     `json:"message,omitempty"`
+	DataDomain DataDomain  `json:"value"`
+	Extra      interface{} `json:"extra"`
+	ResponseStatus
 }
 
 // DomainsResponse represents the response from the list domains API
@@ -67,8 +98,7 @@ type DomainListResponse struct {
 	DataDomains []DataDomain `json:"values"`
 	Pagination  Pagination   `json:"pagination"`
 	Extra       interface{}  `json:"extra,omitempty"`
-	Error       error        `json:"error,omitempty"`
-	Message     string       `json:"message,omitempty"`
+	ResponseStatus
 }
 
 // DataProductAssetType represents the type of a data asset
@@ -117,14 +147,14 @@ func (dp *DataProduct) Validate() error {
 // DataProductResponse represents the response from the create/update data product API
 type DataProductResponse struct {
 	DataProduct DataProduct `json:"value"`
-	Error       error       `json:"error,omitempty"`
-	Message     string      `json:"message,omitempty"`
+	Extra       interface{} `json:"extra"`
+	ResponseStatus
 }
 
 // DataProductListResponse represents the response from the list data products API
 type DataProductListResponse struct {
 	DataProducts []DataProduct `json:"values"`
 	Pagination   Pagination    `json:"pagination"`
-	Error        error         `json:"error,omitempty"`
-	Message      error         `json:"message,omitempty"`
+	Extra        interface{}   `json:"extra,omitempty"`
+	ResponseStatus
 }
@@ -0,0 +1,88 @@
+package masthead
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// DefaultPageSize - Number of items requested per page by list calls
+const DefaultPageSize = 100
+
+// DefaultMaxPages - Upper bound on the number of pages a single list call fetches,
+// protecting against runaway loops when the API reports a wrong total.
+const DefaultMaxPages = 1000
+
+// PageFetcher fetches a single page of results. Pages are numbered from 1.
+type PageFetcher[T any] func(ctx context.Context, page, pageSize int) ([]T, Pagination, error)
+
+// PageOptions configures how Paginate walks through the pages
+type PageOptions struct {
+	PageSize int // Items requested per page, DefaultPageSize if zero
+	MaxPages int // Maximum pages fetched before giving up, DefaultMaxPages if zero
+}
+
+// WithPageSize sets the number of items requested per page by list calls
+func WithPageSize(pageSize int) Option {
+	return func(c *Client) {
+		c.PageSize = pageSize
+	}
+}
+
+// Paginate returns an iterator over the items of all pages returned by fetch.
+//
+// Pages are requested lazily as the caller consumes items, and iteration stops
+// on the first empty page, once Pagination.Total items have been read, or when
+// the caller stops early. A full page is always followed by another request,
+// even when the total has been reached, so that a total that is too low does
+// not drop items. Errors, including exceeding MaxPages, are yielded once and
+// end the iteration.
+func Paginate[T any](ctx context.Context, fetch PageFetcher[T], opts PageOptions) iter.Seq2[T, error] {
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	if opts.MaxPages <= 0 {
+		opts.MaxPages = DefaultMaxPages
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		fetched, total := 0, 0
+
+		for page := 1; ; page++ {
+			if page > opts.MaxPages {
+				yield(zero, fmt.Errorf("pagination stopped after %d pages (%d items read, %d reported by the API)", opts.MaxPages, fetched, total))
+				return
+			}
+
+			items, pagination, err := fetch(ctx, page, opts.PageSize)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			fetched, total = fetched+len(items), pagination.Total
+			if len(items) == 0 || (fetched >= total && len(items) != opts.PageSize) {
+				return
+			}
+		}
+	}
+}
+
+// collect reads all items of a paginated iterator into a slice
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
@@ -0,0 +1,131 @@
+package masthead
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry policy used when no retry option is provided
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMinWait = 1 * time.Second
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// WithRetry sets the retry policy for transient API failures.
+// A maxRetries value of 0 disables retries, and a minWait of 0 retries
+// without waiting. maxWait also caps the waits requested with Retry-After.
+func WithRetry(maxRetries int, minWait, maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.MaxRetries = maxRetries
+		c.RetryMinWait = minWait
+		c.RetryMaxWait = maxWait
+	}
+}
+
+// isIdempotent reports whether a request with the given method can be safely
+// repeated without changing the result on the server.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetryError reports whether a transport error is worth retrying.
+// Callers must not retry once the request context is done; a deadline error
+// otherwise comes from the per-request timeout and is retried like any other
+// transport error. Non-idempotent requests are only retried when the
+// connection could not be established, since the server cannot have processed them.
+func shouldRetryError(method string, err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if isIdempotent(method) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// shouldRetryStatus reports whether a response status is worth retrying.
+// A 429 means the request was rejected before being processed, so it is
+// retried for any method.
+func shouldRetryStatus(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// retryWait returns how long to wait before the given retry attempt (starting
+// at 0). The Retry-After header takes precedence when present, otherwise an
+// exponential backoff with jitter is used. Both are bounded by RetryMaxWait,
+// and a RetryMinWait of 0 retries immediately.
+func (c *Client) retryWait(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, c.RetryMaxWait)
+		}
+	}
+
+	if c.RetryMinWait <= 0 {
+		return 0
+	}
+	wait := c.RetryMinWait << attempt
+	// A non-positive wait means the shift overflowed
+	if wait <= 0 || wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+
+	// Equal jitter: keep half of the backoff and randomize the rest
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + rand.N(half)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleep waits for the given duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package masthead
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout - Default timeout of a single API request
+const DefaultTimeout = 10 * time.Second
+
+// TLSOptions configures TLS for connections to the Masthead API
+type TLSOptions struct {
+	// CACertPEM contains PEM encoded CA certificates trusted in addition to the system pool
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM contain the PEM encoded certificate and key used for mTLS
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables server certificate verification. Only use it for test stacks.
+	InsecureSkipVerify bool
+}
+
+// NewTransport builds an HTTP transport for the Masthead API based on the default transport
+func NewTransport(opts TLSOptions) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // explicitly requested for test stacks
+	}
+
+	if len(opts.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(opts.CACertPEM) {
+			return nil, fmt.Errorf("no valid PEM certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(opts.ClientCertPEM) > 0 || len(opts.ClientKeyPEM) > 0 {
+		if len(opts.ClientCertPEM) == 0 || len(opts.ClientKeyPEM) == 0 {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mTLS")
+		}
+		cert, err := tls.X509KeyPair(opts.ClientCertPEM, opts.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// WithTimeout sets the timeout of a single API request, including reading the response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.HTTPClient.Timeout = timeout
+	}
+}
+
+// WithTransport sets the transport used to send API requests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.HTTPClient.Transport = transport
+	}
+}
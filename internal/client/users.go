@@ -1,39 +1,74 @@
 package masthead
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
-func (c *Client) ListUsers() ([]User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/clientApi/user/list", c.HostURL), nil)
+// ListUsers - Returns all users matching the filter, following pagination.
+// Results are shared between concurrent callers and cached for a short time.
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter) ([]User, error) {
+	return c.users.list(filter, func() ([]User, error) {
+		return collect(c.IterUsers(ctx, filter))
+	})
+}
+
+// IterUsers - Streams all users matching the filter, fetching pages as they are consumed
+func (c *Client) IterUsers(ctx context.Context, filter UserFilter) iter.Seq2[User, error] {
+	return Paginate(ctx, func(ctx context.Context, page, pageSize int) ([]User, Pagination, error) {
+		return c.listUsersPage(ctx, filter, page, pageSize)
+	}, PageOptions{PageSize: c.PageSize})
+}
+
+// listUsersPage - Returns a single page of users matching the filter
+func (c *Client) listUsersPage(ctx context.Context, filter UserFilter, page, pageSize int) ([]User, Pagination, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(pageSize))
+	if filter.Email != "" {
+		query.Set("email", filter.Email)
+	}
+	if filter.Role != "" {
+		query.Set("role", string(filter.Role))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/clientApi/user/list?%s", c.HostURL, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, err
 	}
 
 	body, err := c.doRequest(req)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, err
 	}
 
 	usersResponse := UsersResponse{}
 	err = json.Unmarshal(body, &usersResponse)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, err
+	} else if err := usersResponse.Err(); err != nil {
+		return nil, Pagination{}, err
 	}
 
-	return usersResponse.Users, nil
+	return usersResponse.Users, usersResponse.Pagination, nil
 }
 
-func (c *Client) CreateUser(user User) (*User, error) {
+func (c *Client) CreateUser(ctx context.Context, user User) (*User, error) {
+	// Cached user lists are stale once a user changes, even if the call fails midway
+	defer c.users.invalidate()
+
 	rb, err := json.Marshal(user)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/clientApi/user", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/clientApi/user", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -47,18 +82,22 @@ func (c *Client) CreateUser(user User) (*User, error) {
 	err = json.Unmarshal(body, &userResponse)
 	if err != nil {
 		return nil, err
+	} else if err := userResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &userResponse.User, nil
 }
 
-func (c *Client) UpdateUserRole(user User) (*User, error) {
+func (c *Client) UpdateUserRole(ctx context.Context, user User) (*User, error) {
+	defer c.users.invalidate()
+
 	rb, err := json.Marshal(user)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/clientApi/user/role", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/clientApi/user/role", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -72,18 +111,26 @@ func (c *Client) UpdateUserRole(user User) (*User, error) {
 	err = json.Unmarshal(body, &userResponse)
 	if err != nil {
 		return nil, err
+	} else if err := userResponse.Err(); err != nil {
+		return nil, err
 	}
 
 	return &userResponse.User, nil
 }
 
 // DeleteUser - Remove a user by the email address
-func (c *Client) DeleteUser(email string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/clientApi/user/%s", c.HostURL, email), nil)
+func (c *Client) DeleteUser(ctx context.Context, email string) error {
+	defer c.users.invalidate()
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/clientApi/user/%s", c.HostURL, email), nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.doRequest(req)
-	return err
+	return checkResponseStatus(body)
 }
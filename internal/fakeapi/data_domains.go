@@ -0,0 +1,165 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// AddDomain stores a data domain directly, bypassing the API, e.g. to seed test data.
+// The UUID and timestamps are assigned by the server.
+func (s *Server) AddDomain(domain DataDomain) DataDomain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insertDomain(domain)
+}
+
+// Domain returns the stored data domain with the given UUID
+func (s *Server) Domain(uuid string) (DataDomain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.domainIndex(uuid)
+	if i < 0 {
+		return DataDomain{}, false
+	}
+	return s.domains[i], true
+}
+
+// RemoveDomain deletes a data domain directly, bypassing the API, e.g. to simulate drift
+func (s *Server) RemoveDomain(uuid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeDomain(uuid)
+}
+
+// domainIndex returns the position of the data domain in the store, or -1. Callers must hold s.mu.
+func (s *Server) domainIndex(uuid string) int {
+	return slices.IndexFunc(s.domains, func(d DataDomain) bool {
+		return d.UUID == uuid
+	})
+}
+
+// insertDomain assigns server fields and stores a data domain. Callers must hold s.mu.
+func (s *Server) insertDomain(domain DataDomain) DataDomain {
+	domain.UUID = s.nextUUID()
+	domain.CreatedAt = s.now()
+	domain.UpdatedAt = domain.CreatedAt
+	domain.SlackChannel = slackChannel(domain.SlackChannelName)
+	domain.SlackChannelName = ""
+
+	s.domains = append(s.domains, domain)
+	return domain
+}
+
+// removeDomain deletes a data domain and detaches its data products. Callers must hold s.mu.
+func (s *Server) removeDomain(uuid string) bool {
+	i := s.domainIndex(uuid)
+	if i < 0 {
+		return false
+	}
+	s.domains = slices.Delete(s.domains, i, i+1)
+
+	for j := range s.products {
+		if s.products[j].DataDomainUUID == uuid {
+			s.products[j].DataDomainUUID = ""
+		}
+	}
+	return true
+}
+
+func validateDomain(domain DataDomain) error {
+	if domain.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if domain.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+	return nil
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	domains := slices.Clone(s.domains)
+	s.mu.Unlock()
+
+	writeList(w, r, domains)
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	var domain DataDomain
+	if !decodeBody(w, r, &domain) {
+		return
+	}
+	if err := validateDomain(domain); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_DOMAIN", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeValue(w, s.insertDomain(domain))
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.domainIndex(uuid)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "DOMAIN_NOT_FOUND", fmt.Sprintf("Data domain %s not found", uuid))
+		return
+	}
+
+	writeValue(w, s.domains[i])
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	var domain DataDomain
+	if !decodeBody(w, r, &domain) {
+		return
+	}
+	if err := validateDomain(domain); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_DOMAIN", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.domainIndex(uuid)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "DOMAIN_NOT_FOUND", fmt.Sprintf("Data domain %s not found", uuid))
+		return
+	}
+
+	s.sequence++
+	stored := &s.domains[i]
+	stored.Name = domain.Name
+	stored.Email = domain.Email
+	stored.SlackChannel = slackChannel(domain.SlackChannelName)
+	stored.UpdatedAt = s.now()
+
+	writeValue(w, *stored)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.removeDomain(uuid) {
+		writeError(w, http.StatusNotFound, "DOMAIN_NOT_FOUND", fmt.Sprintf("Data domain %s not found", uuid))
+		return
+	}
+
+	writeValue(w, nil)
+}
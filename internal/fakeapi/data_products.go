@@ -0,0 +1,201 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// AddDataProduct stores a data product directly, bypassing the API, e.g. to seed test data.
+// The UUIDs and timestamps are assigned by the server.
+func (s *Server) AddDataProduct(product DataProduct) DataProduct {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.render(s.insertDataProduct(product))
+}
+
+// DataProduct returns the stored data product with the given UUID
+func (s *Server) DataProduct(uuid string) (DataProduct, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.productIndex(uuid)
+	if i < 0 {
+		return DataProduct{}, false
+	}
+	return s.render(s.products[i]), true
+}
+
+// RemoveDataProduct deletes a data product directly, bypassing the API, e.g. to simulate drift
+func (s *Server) RemoveDataProduct(uuid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.productIndex(uuid); i >= 0 {
+		s.products = slices.Delete(s.products, i, i+1)
+	}
+}
+
+// productIndex returns the position of the data product in the store, or -1. Callers must hold s.mu.
+func (s *Server) productIndex(uuid string) int {
+	return slices.IndexFunc(s.products, func(p DataProduct) bool {
+		return p.UUID == uuid
+	})
+}
+
+// insertDataProduct assigns server fields and stores a data product. Callers must hold s.mu.
+func (s *Server) insertDataProduct(product DataProduct) DataProduct {
+	product.UUID = s.nextUUID()
+	product.CreatedAt = s.now()
+	product.UpdatedAt = product.CreatedAt
+	product.Domain = nil
+	product.DataAssets = s.assignAssets(nil, product.DataAssets)
+
+	s.products = append(s.products, product)
+	return product
+}
+
+// assignAssets fills in asset UUIDs and alert types, keeping the UUIDs of
+// assets that were already attached to the product. Callers must hold s.mu.
+func (s *Server) assignAssets(existing, assets []DataProductAsset) []DataProductAsset {
+	assigned := make([]DataProductAsset, 0, len(assets))
+	for _, asset := range assets {
+		i := slices.IndexFunc(existing, func(e DataProductAsset) bool {
+			return e.Type == asset.Type && e.Project == asset.Project && e.Dataset == asset.Dataset && e.Table == asset.Table
+		})
+		if i >= 0 {
+			asset.UUID = existing[i].UUID
+		} else {
+			asset.UUID = s.nextUUID()
+		}
+		if asset.AlertType == "" {
+			asset.AlertType = "REGULAR"
+		}
+		assigned = append(assigned, asset)
+	}
+	return assigned
+}
+
+// render returns the data product as served by the API, with its data domain embedded. Callers must hold s.mu.
+func (s *Server) render(product DataProduct) DataProduct {
+	product.Domain = nil
+	if i := s.domainIndex(product.DataDomainUUID); i >= 0 {
+		domain := s.domains[i]
+		product.Domain = &domain
+	}
+	product.DataAssets = slices.Clone(product.DataAssets)
+	return product
+}
+
+// validateDataProduct checks the product fields and its data domain reference. Callers must hold s.mu.
+func (s *Server) validateDataProduct(product DataProduct) error {
+	if product.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if product.DataDomainUUID != "" && s.domainIndex(product.DataDomainUUID) < 0 {
+		return fmt.Errorf("data domain %s does not exist", product.DataDomainUUID)
+	}
+	for _, asset := range product.DataAssets {
+		switch {
+		case asset.Type != "DATASET" && asset.Type != "TABLE":
+			return fmt.Errorf("asset type must be DATASET or TABLE, got %q", asset.Type)
+		case asset.Project == "" || asset.Dataset == "":
+			return fmt.Errorf("asset project and dataset are required")
+		case asset.Type == "TABLE" && asset.Table == "":
+			return fmt.Errorf("asset table is required for TABLE assets")
+		}
+	}
+	return nil
+}
+
+func (s *Server) listDataProducts(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	products := make([]DataProduct, 0, len(s.products))
+	for _, product := range s.products {
+		products = append(products, s.render(product))
+	}
+	s.mu.Unlock()
+
+	writeList(w, r, products)
+}
+
+func (s *Server) createDataProduct(w http.ResponseWriter, r *http.Request) {
+	var product DataProduct
+	if !decodeBody(w, r, &product) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validateDataProduct(product); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_DATA_PRODUCT", err.Error())
+		return
+	}
+
+	writeValue(w, s.render(s.insertDataProduct(product)))
+}
+
+func (s *Server) getDataProduct(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.productIndex(uuid)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "DATA_PRODUCT_NOT_FOUND", fmt.Sprintf("Data product %s not found", uuid))
+		return
+	}
+
+	writeValue(w, s.render(s.products[i]))
+}
+
+func (s *Server) updateDataProduct(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	var product DataProduct
+	if !decodeBody(w, r, &product) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.productIndex(uuid)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "DATA_PRODUCT_NOT_FOUND", fmt.Sprintf("Data product %s not found", uuid))
+		return
+	}
+	if err := s.validateDataProduct(product); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_DATA_PRODUCT", err.Error())
+		return
+	}
+
+	stored := &s.products[i]
+	stored.Name = product.Name
+	stored.Description = product.Description
+	stored.DataDomainUUID = product.DataDomainUUID
+	stored.DataAssets = s.assignAssets(stored.DataAssets, product.DataAssets)
+	s.sequence++
+	stored.UpdatedAt = s.now()
+
+	writeValue(w, s.render(*stored))
+}
+
+func (s *Server) deleteDataProduct(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.productIndex(uuid)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "DATA_PRODUCT_NOT_FOUND", fmt.Sprintf("Data product %s not found", uuid))
+		return
+	}
+	s.products = slices.Delete(s.products, i, i+1)
+
+	writeValue(w, nil)
+}
@@ -0,0 +1,176 @@
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+// Fault is a scripted failure injected into the responses of the server, to
+// reproduce throttling, partial outages and misbehaving API responses.
+//
+// A fault applies to the requests matching Method and Path, starting with the
+// Nth matching request and for the following Times requests. Several effects
+// can be combined, e.g. a Delay followed by a Status.
+type Fault struct {
+	// Method of the affected requests, any method if empty
+	Method string
+	// Path of the affected requests, e.g. "/clientApi/user/list", any path if empty
+	Path string
+	// Nth is the first matching request affected, starting at 1. Zero means the first request.
+	Nth int
+	// Times is the number of consecutive matching requests affected. Zero means
+	// a single request and a negative value means every request from the Nth.
+	Times int
+
+	// Delay holds the response back, or until the client gives up on the request
+	Delay time.Duration
+	// Status, when set, rejects the request with this HTTP status and an error
+	// envelope, without processing it
+	Status int
+	// RetryAfter is sent as the Retry-After header of a rejected request
+	RetryAfter string
+	// Code and Message, when Code is set, replace the response with an error
+	// envelope. The request is not processed and the HTTP status is 200 unless
+	// Status is set.
+	Code    string
+	Message string
+	// Truncate cuts the JSON response body in half after processing the request
+	Truncate bool
+	// TotalOffset is added to the pagination total of list responses
+	TotalOffset int
+
+	seen int
+}
+
+// InjectFault schedules a fault. Faults are checked in the order they were
+// injected, and only the first active fault applies to a request.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all scheduled faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// matches reports whether the fault selects the request, ignoring its schedule
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && (f.Path == "" || f.Path == r.URL.Path)
+}
+
+// active counts a matching request and reports whether the fault applies to it
+func (f *Fault) active() bool {
+	f.seen++
+
+	first := max(f.Nth, 1)
+	if f.seen < first {
+		return false
+	}
+	return f.Times < 0 || f.seen < first+max(f.Times, 1)
+}
+
+// fault returns the fault to apply to the request, if any
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var applied *Fault
+	for _, fault := range s.faults {
+		if fault.matches(r) && fault.active() && applied == nil {
+			applied = fault
+		}
+	}
+	return applied
+}
+
+// injectFaults applies the scheduled faults to the requests handled by next
+func (s *Server) injectFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fault := s.fault(r)
+		if fault == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if fault.Delay > 0 {
+			timer := time.NewTimer(fault.Delay)
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+
+		if fault.RetryAfter != "" {
+			w.Header().Set("Retry-After", fault.RetryAfter)
+		}
+		if fault.Status != 0 || fault.Code != "" {
+			status := fault.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			code := fault.Code
+			if code == "" {
+				code = strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+			}
+			message := fault.Message
+			if message == "" {
+				message = fmt.Sprintf("Injected fault: %s", code)
+			}
+			writeError(w, status, code, message)
+			return
+		}
+		if !fault.Truncate && fault.TotalOffset == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Record the response to rewrite its body
+		rec := httptest.NewRecorder()
+		next.ServeHTTP(rec, r)
+
+		body := rec.Body.Bytes()
+		if fault.TotalOffset != 0 {
+			body = offsetTotal(body, fault.TotalOffset)
+		}
+		if fault.Truncate {
+			body = body[:len(body)/2]
+		}
+
+		for key, values := range rec.Header() {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(rec.Code)
+		_, _ = w.Write(body)
+	})
+}
+
+// offsetTotal adds offset to the pagination total of a list response body,
+// leaving other bodies untouched
+func offsetTotal(body []byte, offset int) []byte {
+	var list struct {
+		listResponse
+		Values json.RawMessage `json:"values"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil || list.Values == nil {
+		return body
+	}
+	list.listResponse.Values = list.Values
+	list.Pagination.Total += offset
+
+	var buf bytes.Buffer
+	_ = json.NewEncoder(&buf).Encode(list.listResponse)
+	return buf.Bytes()
+}
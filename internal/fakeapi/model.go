@@ -0,0 +1,93 @@
+package fakeapi
+
+import "time"
+
+// The types below mirror the JSON payloads of the Masthead API. They are kept
+// independent from the client models so that tests catch client-side drift.
+
+// User represents a user in the fake API
+type User struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// SlackChannel represents the Slack channel linked to a data domain
+type SlackChannel struct {
+	Name string `json:"channelName"`
+	ID   string `json:"channelId"`
+}
+
+// DataDomain represents a data domain in the fake API
+type DataDomain struct {
+	UUID             string        `json:"uuid"`
+	Name             string        `json:"name"`
+	Email            string        `json:"email"`
+	SlackChannelName string        `json:"slackChannelName,omitempty"`
+	SlackChannel     *SlackChannel `json:"slackChannel"`
+	CreatedAt        time.Time     `json:"createdAt"`
+	UpdatedAt        time.Time     `json:"updatedAt"`
+}
+
+// DataProductAsset represents a data asset attached to a data product
+type DataProductAsset struct {
+	Type      string `json:"type"`
+	UUID      string `json:"uuid"`
+	Project   string `json:"project"`
+	Dataset   string `json:"dataset"`
+	Table     string `json:"table,omitempty"`
+	AlertType string `json:"alertType"`
+}
+
+// DataProduct represents a data product in the fake API
+type DataProduct struct {
+	UUID           string             `json:"uuid"`
+	Name           string             `json:"name"`
+	DataDomainUUID string             `json:"dataDomainUuid"`
+	Description    string             `json:"description"`
+	Domain         *DataDomain        `json:"domain"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+	DataAssets     []DataProductAsset `json:"dataAssets"`
+}
+
+// Organization represents the Masthead account owning an API token
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TokenInfo describes the API token authenticating a request
+type TokenInfo struct {
+	Name         string       `json:"name"`
+	Organization Organization `json:"organization"`
+	Role         string       `json:"role"`
+	Scopes       []string     `json:"scopes"`
+	ExpiresAt    *time.Time   `json:"expiresAt"`
+}
+
+// pagination is the pagination block of list responses
+type pagination struct {
+	Total int `json:"total"`
+	Page  int `json:"page"`
+}
+
+// errorBody is the error block of response envelopes
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// valueResponse is the envelope of single object responses
+type valueResponse struct {
+	Value any        `json:"value"`
+	Extra any        `json:"extra"`
+	Error *errorBody `json:"error"`
+}
+
+// listResponse is the envelope of list responses
+type listResponse struct {
+	Values     any        `json:"values"`
+	Pagination pagination `json:"pagination"`
+	Extra      any        `json:"extra"`
+	Error      *errorBody `json:"error"`
+}
@@ -0,0 +1,206 @@
+// Package fakeapi provides an in-memory fake of the Masthead API served over
+// HTTP, so that client and provider tests can run offline and deterministically.
+//
+// It implements the /clientApi endpoints documented in internal/client/README.md,
+// including pagination and error envelopes, and can inject scripted faults such
+// as throttling, outages and malformed responses (see Fault).
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultToken - API token accepted by a new fake server
+const DefaultToken = "fake-api-token"
+
+// DefaultPageSize - Page size used when a list request has no limit parameter
+const DefaultPageSize = 20
+
+// RequestIDHeader - Response header carrying the request ID
+const RequestIDHeader = "X-Request-Id"
+
+// Server is an in-memory Masthead API served with httptest.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// Token is the API token expected in the X-API-TOKEN header
+	Token string
+
+	mu        sync.Mutex
+	sequence  int
+	requests  int
+	userAgent string
+	users     []User
+	domains   []DataDomain
+	products  []DataProduct
+	faults    []*Fault
+	tokenInfo TokenInfo
+	createdAt time.Time
+}
+
+// New starts a fake Masthead API server with an empty store.
+// Callers must call Close when done.
+func New() *Server {
+	s := &Server{
+		Token:     DefaultToken,
+		tokenInfo: DefaultTokenInfo(),
+		createdAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /clientApi/token/current", s.currentToken)
+
+	mux.HandleFunc("GET /clientApi/user/list", s.listUsers)
+	mux.HandleFunc("POST /clientApi/user", s.createUser)
+	mux.HandleFunc("PUT /clientApi/user/role", s.updateUserRole)
+	mux.HandleFunc("DELETE /clientApi/user/{email}", s.deleteUser)
+
+	mux.HandleFunc("GET /clientApi/data-domain/list", s.listDomains)
+	mux.HandleFunc("POST /clientApi/data-domain", s.createDomain)
+	mux.HandleFunc("GET /clientApi/data-domain/{uuid}", s.getDomain)
+	mux.HandleFunc("PUT /clientApi/data-domain/{uuid}", s.updateDomain)
+	mux.HandleFunc("DELETE /clientApi/data-domain/{uuid}", s.deleteDomain)
+
+	mux.HandleFunc("GET /clientApi/data-product/list", s.listDataProducts)
+	mux.HandleFunc("POST /clientApi/data-product", s.createDataProduct)
+	mux.HandleFunc("GET /clientApi/data-product/{uuid}", s.getDataProduct)
+	mux.HandleFunc("PUT /clientApi/data-product/{uuid}", s.updateDataProduct)
+	mux.HandleFunc("DELETE /clientApi/data-product/{uuid}", s.deleteDataProduct)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No endpoint %s %s", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(s.authenticate(s.injectFaults(mux)))
+	return s
+}
+
+// Requests returns the number of requests received so far
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// UserAgent returns the User-Agent header of the last request received
+func (s *Server) UserAgent() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.userAgent
+}
+
+// authenticate counts requests, sets a request ID and rejects requests without the expected token
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		s.userAgent = r.UserAgent()
+		requestID := fmt.Sprintf("fake-%d", s.requests)
+		s.mu.Unlock()
+
+		w.Header().Set(RequestIDHeader, requestID)
+
+		if r.Header.Get("X-API-TOKEN") != s.Token {
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or missing API token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// nextUUID returns a deterministic, UUID formatted identifier. Callers must hold s.mu.
+func (s *Server) nextUUID() string {
+	s.sequence++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.sequence)
+}
+
+// now returns a deterministic timestamp that increases with every change. Callers must hold s.mu.
+func (s *Server) now() time.Time {
+	return s.createdAt.Add(time.Duration(s.sequence) * time.Second)
+}
+
+// slackChannel resolves a Slack channel name into the channel returned by the API
+func slackChannel(name string) *SlackChannel {
+	if name == "" {
+		return nil
+	}
+	return &SlackChannel{
+		Name: name,
+		ID:   fmt.Sprintf("C%08X", crc32.ChecksumIEEE([]byte(name))),
+	}
+}
+
+// pageParams reads the page and limit query parameters
+func pageParams(r *http.Request) (page, limit int, err error) {
+	page, limit = 1, DefaultPageSize
+	if value := r.URL.Query().Get("page"); value != "" {
+		if page, err = strconv.Atoi(value); err != nil || page < 1 {
+			return 0, 0, fmt.Errorf("invalid page %q", value)
+		}
+	}
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			return 0, 0, fmt.Errorf("invalid limit %q", value)
+		}
+	}
+	return page, limit, nil
+}
+
+// writeList writes the requested page of values in a list envelope
+func writeList[T any](w http.ResponseWriter, r *http.Request, values []T) {
+	page, limit, err := pageParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_PAGINATION", err.Error())
+		return
+	}
+
+	start := min((page-1)*limit, len(values))
+	end := min(start+limit, len(values))
+
+	writeJSON(w, http.StatusOK, listResponse{
+		Values:     slices.Clone(values[start:end]),
+		Pagination: pagination{Total: len(values), Page: page},
+	})
+}
+
+// writeValue writes a single object in a value envelope
+func writeValue(w http.ResponseWriter, value any) {
+	writeJSON(w, http.StatusOK, valueResponse{Value: value})
+}
+
+// writeError writes an error envelope with the given HTTP status
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, valueResponse{Error: &errorBody{Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeBody decodes the JSON request body, writing a 400 error on failure
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Invalid JSON body: %s", err))
+		return false
+	}
+	return true
+}
+
+// matchesFilter reports whether value matches an optional query filter, ignoring case
+func matchesFilter(r *http.Request, param, value string) bool {
+	filter := r.URL.Query().Get(param)
+	return filter == "" || strings.EqualFold(filter, value)
+}
@@ -0,0 +1,140 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// get sends an authenticated GET request and decodes the JSON response
+func get(t *testing.T, s *Server, path string, out any) int {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, s.URL+path, nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-API-TOKEN", s.Token)
+
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(out))
+	return res.StatusCode
+}
+
+func TestServerAuthentication(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	res, err := http.Get(s.URL + "/clientApi/user/list")
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	var body valueResponse
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	assert.Equal(t, "UNAUTHORIZED", body.Error.Code)
+	assert.NotEmpty(t, res.Header.Get(RequestIDHeader))
+}
+
+func TestServerPagination(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	for i := range 5 {
+		s.AddUser(User{Email: fmt.Sprintf("user%d@example.com", i), Role: "USER"})
+	}
+	s.AddUser(User{Email: "owner@example.com", Role: "OWNER"})
+
+	var page struct {
+		Values     []User     `json:"values"`
+		Pagination pagination `json:"pagination"`
+	}
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list?page=3&limit=2", &page))
+	assert.Equal(t, pagination{Total: 6, Page: 3}, page.Pagination)
+	assert.Equal(t, []User{{Email: "user4@example.com", Role: "USER"}, {Email: "owner@example.com", Role: "OWNER"}}, page.Values)
+
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list?role=OWNER", &page))
+	assert.Equal(t, 1, page.Pagination.Total)
+
+	var errorResponse valueResponse
+	assert.Equal(t, http.StatusBadRequest, get(t, s, "/clientApi/user/list?page=0", &errorResponse))
+	assert.Equal(t, "INVALID_PAGINATION", errorResponse.Error.Code)
+}
+
+func TestServerDataProducts(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	domain := s.AddDomain(DataDomain{Name: "Sales", Email: "sales@example.com", SlackChannelName: "sales"})
+	product := s.AddDataProduct(DataProduct{
+		Name:           "Orders",
+		DataDomainUUID: domain.UUID,
+		DataAssets:     []DataProductAsset{{Type: "DATASET", Project: "p", Dataset: "d"}},
+	})
+
+	var body struct {
+		Value DataProduct `json:"value"`
+	}
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/data-product/"+product.UUID, &body))
+	assert.Equal(t, domain.UUID, body.Value.Domain.UUID)
+	assert.Equal(t, "sales", body.Value.Domain.SlackChannel.Name)
+	assert.Equal(t, "REGULAR", body.Value.DataAssets[0].AlertType)
+	assert.NotEmpty(t, body.Value.DataAssets[0].UUID)
+
+	s.RemoveDataProduct(product.UUID)
+	var errorResponse valueResponse
+	assert.Equal(t, http.StatusNotFound, get(t, s, "/clientApi/data-product/"+product.UUID, &errorResponse))
+	assert.True(t, strings.HasSuffix(errorResponse.Error.Code, "NOT_FOUND"))
+}
+
+func TestServerFaults(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	for i := range 3 {
+		s.AddUser(User{Email: fmt.Sprintf("user%d@example.com", i), Role: "USER"})
+	}
+
+	// Throttle the second and third list requests
+	s.InjectFault(Fault{Method: http.MethodGet, Path: "/clientApi/user/list", Nth: 2, Times: 2, Status: http.StatusTooManyRequests, RetryAfter: "1"})
+
+	var page struct {
+		Values     []User     `json:"values"`
+		Pagination pagination `json:"pagination"`
+	}
+	var errorResponse valueResponse
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list", &page))
+	assert.Equal(t, http.StatusTooManyRequests, get(t, s, "/clientApi/user/list", &errorResponse))
+	assert.Equal(t, "TOO_MANY_REQUESTS", errorResponse.Error.Code)
+	assert.Equal(t, http.StatusTooManyRequests, get(t, s, "/clientApi/user/list", &errorResponse))
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list", &page))
+
+	// Error envelope with HTTP 200
+	s.ClearFaults()
+	s.InjectFault(Fault{Code: "INTERNAL_ERROR", Message: "Something went wrong"})
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list", &errorResponse))
+	assert.Equal(t, &errorBody{Code: "INTERNAL_ERROR", Message: "Something went wrong"}, errorResponse.Error)
+
+	// Lying pagination total
+	s.ClearFaults()
+	s.InjectFault(Fault{TotalOffset: 10})
+	assert.Equal(t, http.StatusOK, get(t, s, "/clientApi/user/list", &page))
+	assert.Equal(t, 13, page.Pagination.Total)
+	assert.Len(t, page.Values, 3)
+
+	// Truncated body
+	s.ClearFaults()
+	s.InjectFault(Fault{Truncate: true})
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/clientApi/user/list", nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-API-TOKEN", s.Token)
+	res, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	assert.Error(t, json.NewDecoder(res.Body).Decode(&page))
+}
@@ -0,0 +1,36 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+)
+
+// DefaultTokenInfo returns the description of the token accepted by a new fake server
+func DefaultTokenInfo() TokenInfo {
+	return TokenInfo{
+		Name:         "fake-token",
+		Organization: Organization{ID: "fake-org", Name: "Fake Organization"},
+		Role:         "OWNER",
+		Scopes:       []string{"data-domains:write", "data-products:write", "users:write"},
+	}
+}
+
+// SetTokenInfo changes the description of the API token returned by the API
+func (s *Server) SetTokenInfo(info TokenInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokenInfo = info
+}
+
+func (s *Server) currentToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	info := s.tokenInfo
+	info.Scopes = slices.Clone(info.Scopes)
+	s.mu.Unlock()
+
+	if info.Scopes == nil {
+		info.Scopes = []string{}
+	}
+	writeValue(w, info)
+}
@@ -0,0 +1,133 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// validRoles are the roles accepted by the user APIs
+var validRoles = []string{"OWNER", "USER"}
+
+// AddUser stores a user directly, bypassing the API, e.g. to seed test data
+func (s *Server) AddUser(user User) User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = append(s.users, user)
+	return user
+}
+
+// User returns the stored user with the given email
+func (s *Server) User(email string) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.userIndex(email)
+	if i < 0 {
+		return User{}, false
+	}
+	return s.users[i], true
+}
+
+// RemoveUser deletes a user directly, bypassing the API, e.g. to simulate drift
+func (s *Server) RemoveUser(email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if i := s.userIndex(email); i >= 0 {
+		s.users = slices.Delete(s.users, i, i+1)
+	}
+}
+
+// userIndex returns the position of the user in the store, or -1. Callers must hold s.mu.
+func (s *Server) userIndex(email string) int {
+	return slices.IndexFunc(s.users, func(u User) bool {
+		return strings.EqualFold(u.Email, email)
+	})
+}
+
+func validateUser(user User) error {
+	if user.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if !slices.Contains(validRoles, user.Role) {
+		return fmt.Errorf("role must be one of %s", strings.Join(validRoles, ", "))
+	}
+	return nil
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	users := []User{}
+	for _, user := range s.users {
+		if matchesFilter(r, "email", user.Email) && matchesFilter(r, "role", user.Role) {
+			users = append(users, user)
+		}
+	}
+	s.mu.Unlock()
+
+	writeList(w, r, users)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var user User
+	if !decodeBody(w, r, &user) {
+		return
+	}
+	if err := validateUser(user); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_USER", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.userIndex(user.Email) >= 0 {
+		writeError(w, http.StatusConflict, "USER_ALREADY_EXISTS", fmt.Sprintf("User %s already exists", user.Email))
+		return
+	}
+	s.users = append(s.users, user)
+
+	writeValue(w, user)
+}
+
+func (s *Server) updateUserRole(w http.ResponseWriter, r *http.Request) {
+	var user User
+	if !decodeBody(w, r, &user) {
+		return
+	}
+	if err := validateUser(user); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_USER", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.userIndex(user.Email)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "USER_NOT_FOUND", fmt.Sprintf("User %s not found", user.Email))
+		return
+	}
+	s.users[i].Role = user.Role
+
+	writeValue(w, s.users[i])
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	email := r.PathValue("email")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.userIndex(email)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "USER_NOT_FOUND", fmt.Sprintf("User %s not found", email))
+		return
+	}
+	s.users = slices.Delete(s.users, i, i+1)
+
+	writeValue(w, nil)
+}
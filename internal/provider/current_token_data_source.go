@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CurrentTokenDataSource{}
+
+func NewCurrentTokenDataSource() datasource.DataSource {
+	return &CurrentTokenDataSource{}
+}
+
+// CurrentTokenDataSource defines the data source implementation.
+type CurrentTokenDataSource struct {
+	client masthead.MastheadAPI
+}
+
+// CurrentTokenDataSourceModel describes the data source data model.
+type CurrentTokenDataSourceModel struct {
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Name             types.String `tfsdk:"name"`
+	Role             types.String `tfsdk:"role"`
+	Scopes           types.List   `tfsdk:"scopes"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+}
+
+func (d *CurrentTokenDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_token"
+}
+
+func (d *CurrentTokenDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the identity behind the API token the provider is configured with. " +
+			"**Experimental:** the `/clientApi/token/current` endpoint it reads has not been confirmed on the live Masthead API, so reading this data source may fail.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Masthead organization owning the token",
+				Computed:            true,
+			},
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Masthead organization owning the token",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API token",
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted to the API token (supported values: USER, OWNER)",
+				Computed:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes granted to the API token",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry of the API token, as an RFC 3339 timestamp. Null if the token does not expire.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CurrentTokenDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrentTokenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tokenInfo, err := d.client.CurrentToken(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the current API token, got error: %s", err))
+		return
+	}
+
+	// An empty list rather than null, so that contains() works in conditions
+	if tokenInfo.Scopes == nil {
+		tokenInfo.Scopes = []string{}
+	}
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, tokenInfo.Scopes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := CurrentTokenDataSourceModel{
+		OrganizationID:   types.StringValue(tokenInfo.Organization.ID),
+		OrganizationName: types.StringValue(tokenInfo.Organization.Name),
+		Name:             types.StringValue(tokenInfo.Name),
+		Role:             types.StringValue(string(tokenInfo.Role)),
+		Scopes:           scopes,
+		ExpiresAt:        types.StringNull(),
+	}
+	if tokenInfo.ExpiresAt != nil {
+		state.ExpiresAt = types.StringValue(tokenInfo.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
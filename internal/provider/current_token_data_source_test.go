@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccCurrentTokenDataSource(t *testing.T) {
+	server := testAccServer(t)
+	expiresAt := time.Date(2030, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_current_token" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("organization_id"), knownvalue.StringExact("fake-org")),
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("organization_name"), knownvalue.StringExact("Fake Organization")),
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("role"), knownvalue.StringExact("OWNER")),
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("expires_at"), knownvalue.Null()),
+				},
+			},
+			// The organization can be asserted with a postcondition
+			{
+				PreConfig: func() {
+					server.SetTokenInfo(fakeapi.TokenInfo{
+						Name:         "ci",
+						Organization: fakeapi.Organization{ID: "org-staging", Name: "Staging"},
+						Role:         "USER",
+						Scopes:       []string{"users:read"},
+						ExpiresAt:    &expiresAt,
+					})
+				},
+				Config: testAccProviderConfig(server) + `
+data "masthead_current_token" "test" {
+  lifecycle {
+    postcondition {
+      condition     = self.organization_id == "org-production"
+      error_message = "Expected the production organization, got ${self.organization_name}."
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Expected the production organization, got Staging"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_current_token" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("name"), knownvalue.StringExact("ci")),
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("scopes"), knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("users:read")})),
+					statecheck.ExpectKnownValue("data.masthead_current_token.test", tfjsonpath.New("expires_at"), knownvalue.StringExact("2030-06-01T12:00:00Z")),
+				},
+			},
+		},
+	})
+}
@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ datasource.DataSource = &DataDomainDataSource{}
+var (
+	_ datasource.DataSource                     = &DataDomainDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &DataDomainDataSource{}
+)
 
 func NewDataDomainDataSource() datasource.DataSource {
 	return &DataDomainDataSource{}
@@ -19,7 +24,16 @@ func NewDataDomainDataSource() datasource.DataSource {
 
 // DataDomainDataSource defines the data source implementation.
 type DataDomainDataSource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
+}
+
+// DataDomainDataSourceModel describes the data source data model.
+type DataDomainDataSourceModel struct {
+	UUID             types.String `tfsdk:"uuid"`
+	Name             types.String `tfsdk:"name"`
+	Email            types.String `tfsdk:"email"`
+	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+	SlackChannelID   types.String `tfsdk:"slack_channel_id"`
 }
 
 func (d *DataDomainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -28,14 +42,16 @@ func (d *DataDomainDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 func (d *DataDomainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetch information about a Masthead data domain",
+		MarkdownDescription: "Fetch information about a Masthead data domain, by UUID or by name",
 		Attributes: map[string]schema.Attribute{
 			"uuid": schema.StringAttribute{
-				MarkdownDescription: "UUID of the data domain",
-				Required:            true,
+				MarkdownDescription: "UUID of the data domain. Exactly one of `uuid` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the data domain",
+				MarkdownDescription: "Name of the data domain, case sensitive. Exactly one of `uuid` or `name` must be set. An error is reported when no data domain or several data domains have this name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"email": schema.StringAttribute{
@@ -46,21 +62,34 @@ func (d *DataDomainDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Slack channel name associated with the data domain",
 				Computed:            true,
 			},
+			"slack_channel_id": schema.StringAttribute{
+				MarkdownDescription: "Slack channel ID associated with the data domain",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+func (d *DataDomainDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *DataDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -69,8 +98,8 @@ func (d *DataDomainDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *DataDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config DataDomainResourceModel
-	var state DataDomainResourceModel
+	var config DataDomainDataSourceModel
+	var state DataDomainDataSourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -78,22 +107,44 @@ func (d *DataDomainDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	// Get the data domain from Masthead API
-	domainResponse, err := d.client.GetDomain(config.UUID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data domain, got error: %s", err))
-		return
+	// Get the data domain from Masthead API, by UUID or by name
+	var domainResponse *masthead.DataDomain
+	if !config.UUID.IsNull() {
+		var err error
+		domainResponse, err = d.client.GetDomain(ctx, config.UUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data domain, got error: %s", err))
+			return
+		}
+	} else {
+		domainResponse = lookupDataDomainByName(ctx, d.client, config.Name.ValueString(), &resp.Diagnostics)
+		if domainResponse == nil {
+			return
+		}
 	}
 
 	// Map response body to model
-	state.Name = types.StringValue(domainResponse.Name)
-	state.Email = types.StringValue(domainResponse.Email)
-	if domainResponse.SlackChannel != (masthead.SlackChannel{}) {
-		state.SlackChannelName = types.StringValue(domainResponse.SlackChannel.Name)
+	state = dataDomainDataSourceModel(*domainResponse)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// dataDomainDataSourceModel maps a data domain returned by the API to the data
+// source model, also used by the entries of the masthead_data_domains data source
+func dataDomainDataSourceModel(domain masthead.DataDomain) DataDomainDataSourceModel {
+	var state DataDomainDataSourceModel
+
+	state.UUID = types.StringValue(domain.UUID)
+	state.Name = types.StringValue(domain.Name)
+	state.Email = types.StringValue(domain.Email)
+	if domain.SlackChannel != (masthead.SlackChannel{}) {
+		state.SlackChannelName = types.StringValue(domain.SlackChannel.Name)
+		state.SlackChannelID = types.StringValue(domain.SlackChannel.ID)
 	} else {
 		state.SlackChannelName = types.StringNull()
+		state.SlackChannelID = types.StringNull()
 	}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	return state
 }
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataDomainDataSource(t *testing.T) {
+	server := testAccServer(t)
+	domain := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com", SlackChannelName: "sales-data"})
+	finance := server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance@example.com"})
+	server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance-emea@example.com"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domain" "test" {
+  uuid = "00000000-0000-4000-8000-999999999999"
+}
+`,
+				ExpectError: regexp.MustCompile("DOMAIN_NOT_FOUND"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domain" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of these attributes must be configured"),
+			},
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "masthead_data_domain" "test" {
+  uuid = %q
+  name = "Sales"
+}
+`, domain.UUID),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domain" "test" {
+  name = "Marketing"
+}
+`,
+				ExpectError: regexp.MustCompile("No data domain is named \"Marketing\""),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domain" "test" {
+  name = "Finance"
+}
+`,
+				ExpectError: regexp.MustCompile("2 data domains are named \"Finance\""),
+			},
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "masthead_data_domain" "by_uuid" {
+  uuid = %q
+}
+
+data "masthead_data_domain" "by_name" {
+  name = "Sales"
+}
+
+data "masthead_data_domain" "without_slack" {
+  uuid = %q
+}
+`, domain.UUID, finance.UUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_uuid", tfjsonpath.New("uuid"), knownvalue.StringExact(domain.UUID)),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_uuid", tfjsonpath.New("name"), knownvalue.StringExact("Sales")),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_uuid", tfjsonpath.New("email"), knownvalue.StringExact("sales@example.com")),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_uuid", tfjsonpath.New("slack_channel_name"), knownvalue.StringExact("sales-data")),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_uuid", tfjsonpath.New("slack_channel_id"), knownvalue.StringExact(domain.SlackChannel.ID)),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_name", tfjsonpath.New("uuid"), knownvalue.StringExact(domain.UUID)),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_name", tfjsonpath.New("email"), knownvalue.StringExact("sales@example.com")),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_name", tfjsonpath.New("slack_channel_name"), knownvalue.StringExact("sales-data")),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.by_name", tfjsonpath.New("slack_channel_id"), knownvalue.StringExact(domain.SlackChannel.ID)),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.without_slack", tfjsonpath.New("slack_channel_name"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("data.masthead_data_domain.without_slack", tfjsonpath.New("slack_channel_id"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
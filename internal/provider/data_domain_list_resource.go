@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResourceWithConfigure = &DataDomainListResource{}
+
+func NewDataDomainListResource() list.ListResource {
+	return &DataDomainListResource{}
+}
+
+// DataDomainListResource lists the data domains of the account, e.g. for terraform query.
+type DataDomainListResource struct {
+	client masthead.MastheadAPI
+}
+
+// DataDomainListResourceModel describes the list configuration.
+type DataDomainListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func (r *DataDomainListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_domain"
+}
+
+func (r *DataDomainListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists Masthead data domains",
+		Attributes: map[string]listschema.Attribute{
+			"name_prefix": schemaNamePrefixAttribute("data domains"),
+		},
+	}
+}
+
+func (r *DataDomainListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DataDomainListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config DataDomainListResourceModel
+	var diags diag.Diagnostics
+
+	// The config block of list blocks is optional
+	if !req.Config.Raw.IsNull() {
+		diags.Append(req.Config.Get(ctx, &config)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	match := func(domain masthead.DataDomain) bool {
+		return strings.HasPrefix(domain.Name, config.NamePrefix.ValueString())
+	}
+
+	stream.Results = listResults(ctx, req, "data domains", r.client.IterDomains(ctx), match, func(domain masthead.DataDomain, result *list.ListResult) {
+		result.DisplayName = domain.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, DataDomainResourceIdentityModel{UUID: types.StringValue(domain.UUID)})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, dataDomainResourceModel(domain))...)
+		}
+	})
+}
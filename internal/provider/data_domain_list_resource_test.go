@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataDomainListResource(t *testing.T) {
+	server := testAccServer(t)
+	sales := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com", SlackChannelName: "sales-data"})
+	server.AddDomain(fakeapi.DataDomain{Name: "Sales EMEA", Email: "sales-emea@example.com"})
+	finance := server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance@example.com"})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The provider configuration of the first step is reused by query steps
+			{
+				Config: testAccProviderConfig(server),
+			},
+			{
+				Query: true,
+				Config: `
+list "masthead_data_domain" "all" {
+  provider = masthead
+}
+
+list "masthead_data_domain" "sales" {
+  provider         = masthead
+  include_resource = true
+
+  config {
+    name_prefix = "Sales"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("masthead_data_domain.all", 3),
+					querycheck.ExpectLength("masthead_data_domain.sales", 2),
+					querycheck.ExpectIdentity("masthead_data_domain.sales", map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(sales.UUID),
+					}),
+					querycheck.ExpectNoIdentity("masthead_data_domain.sales", map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(finance.UUID),
+					}),
+					querycheck.ExpectResourceDisplayName("masthead_data_domain.sales", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(sales.UUID),
+					}), knownvalue.StringExact("Sales")),
+					querycheck.ExpectResourceKnownValues("masthead_data_domain.sales", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(sales.UUID),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("email"), KnownValue: knownvalue.StringExact("sales@example.com")},
+						{Path: tfjsonpath.New("slack_channel_name"), KnownValue: knownvalue.StringExact("sales-data")},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestDataDomainListResourceList(t *testing.T) {
+	domains := []masthead.DataDomain{
+		{UUID: "d1", Name: "Sales", Email: "sales@example.com"},
+		{UUID: "d2", Name: "Sales EMEA", Email: "sales-emea@example.com"},
+		{UUID: "d3", Name: "Finance", Email: "finance@example.com"},
+	}
+	var consumed int
+	api := &mockAPI{
+		IterDomainsFunc: func(ctx context.Context) iter.Seq2[masthead.DataDomain, error] {
+			consumed = 0
+			return seqOf(&consumed, domains...)
+		},
+	}
+	r := configuredListResource(t, &DataDomainListResource{}, api)
+
+	results := listResourceResults(t, r, &DataDomainResource{}, DataDomainListResourceModel{NamePrefix: types.StringValue("Sales")}, 0, true)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "Sales", results[0].DisplayName)
+		var identity DataDomainResourceIdentityModel
+		results[0].Identity.Get(context.Background(), &identity)
+		assert.Equal(t, "d1", identity.UUID.ValueString())
+		var model DataDomainResourceModel
+		results[1].Resource.Get(context.Background(), &model)
+		assert.Equal(t, dataDomainResourceModel(domains[1]), model)
+	}
+
+	results = listResourceResults(t, r, &DataDomainResource{}, DataDomainListResourceModel{NamePrefix: types.StringNull()}, 2, false)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "Sales EMEA", results[1].DisplayName)
+		assert.True(t, results[1].Resource.Raw.IsNull())
+	}
+	assert.Equal(t, 2, consumed, "No data domain should be read past the limit")
+
+	api.IterDomainsFunc = func(ctx context.Context) iter.Seq2[masthead.DataDomain, error] {
+		return errorSeq[masthead.DataDomain](errors.New("status: 500"))
+	}
+	results = listResourceResults(t, r, &DataDomainResource{}, DataDomainListResourceModel{NamePrefix: types.StringNull()}, 0, false)
+	if assert.Len(t, results, 1) && assert.True(t, results[0].Diagnostics.HasError()) {
+		assert.Contains(t, results[0].Diagnostics[0].Detail(), "Unable to list data domains")
+	}
+}
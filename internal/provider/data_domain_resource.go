@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -17,6 +19,7 @@ import (
 var (
 	_ resource.Resource                = &DataDomainResource{}
 	_ resource.ResourceWithImportState = &DataDomainResource{}
+	_ resource.ResourceWithIdentity    = &DataDomainResource{}
 )
 
 func NewDataDomainResource() resource.Resource {
@@ -25,7 +28,7 @@ func NewDataDomainResource() resource.Resource {
 
 // DataDomainResource defines the resource implementation.
 type DataDomainResource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
 }
 
 type DataDomainResourceModel struct {
@@ -35,6 +38,11 @@ type DataDomainResourceModel struct {
 	SlackChannelName types.String `tfsdk:"slack_channel_name"`
 }
 
+// DataDomainResourceIdentityModel describes the identity of the resource.
+type DataDomainResourceIdentityModel struct {
+	UUID types.String `tfsdk:"uuid"`
+}
+
 func (r *DataDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_data_domain"
 }
@@ -66,17 +74,28 @@ func (r *DataDomainResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *DataDomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"uuid": identityschema.StringAttribute{
+				Description:       "UUID of the data domain",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *DataDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -101,24 +120,18 @@ func (r *DataDomainResource) Create(ctx context.Context, req resource.CreateRequ
 		SlackChannelName: plan.SlackChannelName.ValueString(),
 	}
 
-	domainResponse, err := r.client.CreateDomain(domainRequest)
+	domainResponse, err := r.client.CreateDomain(ctx, domainRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create data domain, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(domainResponse.UUID)
-	state.Name = types.StringValue(domainResponse.Name)
-	state.Email = types.StringValue(domainResponse.Email)
-	if domainResponse.SlackChannel != (masthead.SlackChannel{}) {
-		state.SlackChannelName = types.StringValue(domainResponse.SlackChannel.Name)
-	} else {
-		state.SlackChannelName = types.StringNull()
-	}
+	state = dataDomainResourceModel(*domainResponse)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataDomainResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -132,24 +145,22 @@ func (r *DataDomainResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get domain by UUID
-	domainResponse, err := r.client.GetDomain(plan.UUID.ValueString())
-	if err != nil {
+	domainResponse, err := r.client.GetDomain(ctx, plan.UUID.ValueString())
+	if masthead.IsNotFound(err) {
+		// The data domain was deleted outside of Terraform, remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data domain, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(domainResponse.UUID)
-	state.Name = types.StringValue(domainResponse.Name)
-	state.Email = types.StringValue(domainResponse.Email)
-	if domainResponse.SlackChannel != (masthead.SlackChannel{}) {
-		state.SlackChannelName = types.StringValue(domainResponse.SlackChannel.Name)
-	} else {
-		state.SlackChannelName = types.StringNull()
-	}
+	state = dataDomainResourceModel(*domainResponse)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataDomainResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -172,24 +183,18 @@ func (r *DataDomainResource) Update(ctx context.Context, req resource.UpdateRequ
 		SlackChannelName: plan.SlackChannelName.ValueString(),
 	}
 
-	domainResponse, err := r.client.UpdateDomain(domainRequest)
+	domainResponse, err := r.client.UpdateDomain(ctx, domainRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update data domain, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(domainResponse.UUID)
-	state.Name = types.StringValue(domainResponse.Name)
-	state.Email = types.StringValue(domainResponse.Email)
-	if domainResponse.SlackChannel != (masthead.SlackChannel{}) {
-		state.SlackChannelName = types.StringValue(domainResponse.SlackChannel.Name)
-	} else {
-		state.SlackChannelName = types.StringNull()
-	}
+	state = dataDomainResourceModel(*domainResponse)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataDomainResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -202,13 +207,49 @@ func (r *DataDomainResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete domain
-	err := r.client.DeleteDomain(domain.UUID.ValueString())
-	if err != nil {
+	err := r.client.DeleteDomain(ctx, domain.UUID.ValueString())
+	if err != nil && !masthead.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data domain, got error: %s", err))
 		return
 	}
 }
 
+// ImportState accepts the UUID of the data domain, as import ID or identity,
+// or its name as "name:<domain name>"
 func (r *DataDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	name, byName := strings.CutPrefix(req.ID, importNamePrefix)
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("uuid"), req, resp)
+		return
+	}
+
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the UUID of a data domain or \"name:<domain name>\", got %q.", req.ID),
+		)
+		return
+	}
+
+	domain := lookupDataDomainByName(ctx, r.client, name, &resp.Diagnostics)
+	if domain == nil {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), domain.UUID)...)
+}
+
+// dataDomainResourceModel maps a data domain returned by the API to the resource model
+func dataDomainResourceModel(domain masthead.DataDomain) DataDomainResourceModel {
+	var state DataDomainResourceModel
+
+	state.UUID = types.StringValue(domain.UUID)
+	state.Name = types.StringValue(domain.Name)
+	state.Email = types.StringValue(domain.Email)
+	if domain.SlackChannel != (masthead.SlackChannel{}) {
+		state.SlackChannelName = types.StringValue(domain.SlackChannel.Name)
+	} else {
+		state.SlackChannelName = types.StringNull()
+	}
+
+	return state
 }
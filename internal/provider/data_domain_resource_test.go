@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataDomainResource(t *testing.T) {
+	server := testAccServer(t)
+	var domainUUID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "masthead_data_domain" "test" {
+  name               = "Sales"
+  email              = "sales@example.com"
+  slack_channel_name = "sales-data"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("masthead_data_domain.test", tfjsonpath.New("uuid")),
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("name"), knownvalue.StringExact("Sales")),
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("email"), knownvalue.StringExact("sales@example.com")),
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("slack_channel_name"), knownvalue.StringExact("sales-data")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					domainUUID = s.RootModule().Resources["masthead_data_domain.test"].Primary.Attributes["uuid"]
+					return nil
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "masthead_data_domain.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccAttributeImportStateID("masthead_data_domain.test", "uuid"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+			// Import by identity, with an import block
+			{
+				ResourceName:    "masthead_data_domain.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import by name
+			{
+				ResourceName:                         "masthead_data_domain.test",
+				ImportState:                          true,
+				ImportStateId:                        "name:Sales",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+			{
+				ResourceName:  "masthead_data_domain.test",
+				ImportState:   true,
+				ImportStateId: "name:Marketing",
+				ExpectError:   regexp.MustCompile("No data domain is named \"Marketing\""),
+			},
+			// Update and Read testing, removing the Slack channel
+			{
+				Config: testAccProviderConfig(server) + `
+resource "masthead_data_domain" "test" {
+  name  = "Sales & Marketing"
+  email = "sales@example.com"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_data_domain.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("name"), knownvalue.StringExact("Sales & Marketing")),
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("slack_channel_name"), knownvalue.Null()),
+				},
+			},
+			// Drift testing: the domain is recreated after being deleted outside of Terraform
+			{
+				PreConfig: func() {
+					server.RemoveDomain(domainUUID)
+				},
+				Config: testAccProviderConfig(server) + `
+resource "masthead_data_domain" "test" {
+  name  = "Sales & Marketing"
+  email = "sales@example.com"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_data_domain.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if _, ok := server.Domain(rs.Primary.Attributes["uuid"]); ok {
+					return fmt.Errorf("data domain %s still exists", rs.Primary.Attributes["uuid"])
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// testAccAttributeImportStateID returns the import ID of a resource from one of its attributes
+func testAccAttributeImportStateID(resourceName, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes[attribute], nil
+	}
+}
+
+// TestAccDataDomainResource_faults ensures transient API failures are retried
+// and malformed responses are reported as errors
+func TestAccDataDomainResource_faults(t *testing.T) {
+	server := testAccServer(t)
+	// Faults target every GET request, skip the credentials check so that
+	// they only affect the data domain refresh
+	config := strings.Replace(testAccProviderConfig(server), "provider \"masthead\" {", "provider \"masthead\" {\n  skip_credentials_validation = true", 1) + `
+resource "masthead_data_domain" "test" {
+  name  = "Sales"
+  email = "sales@example.com"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Throttled create and an outage during refresh are retried
+			{
+				PreConfig: func() {
+					server.InjectFault(fakeapi.Fault{Method: http.MethodPost, Status: http.StatusTooManyRequests, RetryAfter: "0"})
+					server.InjectFault(fakeapi.Fault{Method: http.MethodGet, Times: 2, Status: http.StatusServiceUnavailable})
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("masthead_data_domain.test", tfjsonpath.New("name"), knownvalue.StringExact("Sales")),
+				},
+			},
+			// An error envelope returned with HTTP 200 fails the refresh
+			{
+				PreConfig: func() {
+					server.ClearFaults()
+					server.InjectFault(fakeapi.Fault{Method: http.MethodGet, Times: -1, Code: "INTERNAL_ERROR", Message: "Something went wrong"})
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile("INTERNAL_ERROR"),
+			},
+			// A truncated response fails the refresh
+			{
+				PreConfig: func() {
+					server.ClearFaults()
+					server.InjectFault(fakeapi.Fault{Method: http.MethodGet, Times: -1, Truncate: true})
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile("Unable to read data domain"),
+			},
+			// The resource is usable again once the API recovers
+			{
+				PreConfig: server.ClearFaults,
+				Config:    config,
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+func TestDataDomainResourceRead(t *testing.T) {
+	ctx := context.Background()
+	api := &mockAPI{
+		GetDomainFunc: func(ctx context.Context, dataDomainID string) (*masthead.DataDomain, error) {
+			switch dataDomainID {
+			case "d1":
+				return &masthead.DataDomain{UUID: "d1", Name: "Sales", Email: "sales@example.com", SlackChannel: masthead.SlackChannel{Name: "sales-data", ID: "C1"}}, nil
+			case "d2":
+				return &masthead.DataDomain{UUID: "d2", Name: "Finance", Email: "finance@example.com"}, nil
+			case "gone":
+				return nil, &masthead.APIError{StatusCode: 404, Code: "DOMAIN_NOT_FOUND"}
+			}
+			return nil, &masthead.APIError{StatusCode: 500, Code: "INTERNAL_ERROR"}
+		},
+	}
+	r := configuredResource(t, &DataDomainResource{}, api)
+
+	read := func(uuid string) fwresource.ReadResponse {
+		prior := DataDomainResourceModel{UUID: types.StringValue(uuid), Name: types.StringValue("Old"), Email: types.StringValue("old@example.com"), SlackChannelName: types.StringValue("old")}
+		resp := fwresource.ReadResponse{State: resourceState(t, r, &prior), Identity: resourceIdentity(t, r)}
+		r.Read(ctx, fwresource.ReadRequest{State: resourceState(t, r, &prior)}, &resp)
+		return resp
+	}
+
+	resp := read("d1")
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	var state DataDomainResourceModel
+	resp.State.Get(ctx, &state)
+	assert.Equal(t, DataDomainResourceModel{
+		UUID:             types.StringValue("d1"),
+		Name:             types.StringValue("Sales"),
+		Email:            types.StringValue("sales@example.com"),
+		SlackChannelName: types.StringValue("sales-data"),
+	}, state)
+
+	// A domain without a Slack channel maps to a null channel name
+	resp = read("d2")
+	resp.State.Get(ctx, &state)
+	assert.True(t, state.SlackChannelName.IsNull())
+
+	// A domain deleted outside of Terraform is removed from state
+	resp = read("gone")
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+
+	// Other API errors are reported as diagnostics
+	resp = read("broken")
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Client Error", resp.Diagnostics[0].Summary())
+}
+
+func TestDataDomainResourceDelete(t *testing.T) {
+	var deleted []string
+	api := &mockAPI{
+		DeleteDomainFunc: func(ctx context.Context, domainID string) error {
+			deleted = append(deleted, domainID)
+			return &masthead.APIError{StatusCode: 404, Code: "DOMAIN_NOT_FOUND"}
+		},
+	}
+	r := configuredResource(t, &DataDomainResource{}, api)
+
+	prior := DataDomainResourceModel{UUID: types.StringValue("d1"), Name: types.StringValue("Sales"), Email: types.StringValue("sales@example.com"), SlackChannelName: types.StringNull()}
+	resp := fwresource.DeleteResponse{State: resourceState(t, r, &prior)}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: resourceState(t, r, &prior)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "Deleting a missing domain should succeed: %v", resp.Diagnostics)
+	assert.Equal(t, []string{"d1"}, deleted)
+}
+
+func TestDataDomainResourceImportState(t *testing.T) {
+	api := &mockAPI{
+		ListDomainsFunc: func(ctx context.Context) ([]masthead.DataDomain, error) {
+			return []masthead.DataDomain{
+				{UUID: "d1", Name: "Sales"},
+				{UUID: "d2", Name: "Finance"},
+				{UUID: "d3", Name: "Finance"},
+			}, nil
+		},
+	}
+	r := configuredResource(t, &DataDomainResource{}, api)
+
+	importState := func(id string) fwresource.ImportStateResponse {
+		resp := fwresource.ImportStateResponse{State: resourceState(t, r, nil)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+
+	resp := importState("name:Sales")
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	var uuid types.String
+	resp.State.GetAttribute(context.Background(), path.Root("uuid"), &uuid)
+	assert.Equal(t, "d1", uuid.ValueString())
+
+	resp = importState("name:Finance")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Ambiguous Data Domain Name", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "d2, d3")
+	}
+
+	resp = importState("name:sales")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Data Domain Not Found", resp.Diagnostics[0].Summary())
+	}
+
+	resp = importState("name:")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Invalid Import ID", resp.Diagnostics[0].Summary())
+	}
+}
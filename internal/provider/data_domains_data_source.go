@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DataDomainsDataSource{}
+
+func NewDataDomainsDataSource() datasource.DataSource {
+	return &DataDomainsDataSource{}
+}
+
+// DataDomainsDataSource defines the data source implementation.
+type DataDomainsDataSource struct {
+	client masthead.MastheadAPI
+}
+
+// DataDomainsDataSourceModel describes the data source data model.
+type DataDomainsDataSourceModel struct {
+	NameRegex   types.String                `tfsdk:"name_regex"`
+	DataDomains []DataDomainDataSourceModel `tfsdk:"data_domains"`
+}
+
+func (d *DataDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_domains"
+}
+
+func (d *DataDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the Masthead data domains matching the filters, sorted by name and UUID",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return data domains whose name matches this [regular expression](https://pkg.go.dev/regexp/syntax)",
+				Optional:            true,
+			},
+			"data_domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Data domains matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "UUID of the data domain",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the data domain",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email associated with the data domain",
+							Computed:            true,
+						},
+						"slack_channel_name": schema.StringAttribute{
+							MarkdownDescription: "Slack channel name associated with the data domain",
+							Computed:            true,
+						},
+						"slack_channel_id": schema.StringAttribute{
+							MarkdownDescription: "Slack channel ID associated with the data domain",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataDomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	nameRegex := compileRegexFilter(path.Root("name_regex"), state.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainsResponse, err := d.client.ListDomains(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data domains, got error: %s", err))
+		return
+	}
+
+	state.DataDomains = []DataDomainDataSourceModel{}
+	for _, domain := range domainsResponse {
+		if matchesRegexFilter(nameRegex, domain.Name) {
+			state.DataDomains = append(state.DataDomains, dataDomainDataSourceModel(domain))
+		}
+	}
+	slices.SortFunc(state.DataDomains, func(a, b DataDomainDataSourceModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Name.ValueString(), b.Name.ValueString()),
+			cmp.Compare(a.UUID.ValueString(), b.UUID.ValueString()),
+		)
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataDomainsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	salesEMEA := server.AddDomain(fakeapi.DataDomain{Name: "Sales EMEA", Email: "sales-emea@example.com"})
+	server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance@example.com"})
+	sales := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com", SlackChannelName: "sales-data"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domains" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_data_domains" "all" {}
+
+data "masthead_data_domains" "sales" {
+  name_regex = "^Sales"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_data_domains.all", tfjsonpath.New("data_domains"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("data.masthead_data_domains.all", tfjsonpath.New("data_domains").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("Finance")),
+					statecheck.ExpectKnownValue("data.masthead_data_domains.sales", tfjsonpath.New("data_domains"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"uuid":               knownvalue.StringExact(sales.UUID),
+							"name":               knownvalue.StringExact("Sales"),
+							"email":              knownvalue.StringExact("sales@example.com"),
+							"slack_channel_name": knownvalue.StringExact("sales-data"),
+							"slack_channel_id":   knownvalue.StringExact(sales.SlackChannel.ID),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"uuid":               knownvalue.StringExact(salesEMEA.UUID),
+							"name":               knownvalue.StringExact("Sales EMEA"),
+							"email":              knownvalue.StringExact("sales-emea@example.com"),
+							"slack_channel_name": knownvalue.Null(),
+							"slack_channel_id":   knownvalue.Null(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
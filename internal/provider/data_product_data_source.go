@@ -19,7 +19,7 @@ func NewDataProductDataSource() datasource.DataSource {
 
 // DataProductDataSource defines the data source implementation.
 type DataProductDataSource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
 }
 
 func (d *DataProductDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,38 +46,7 @@ func (d *DataProductDataSource) Schema(ctx context.Context, req datasource.Schem
 				MarkdownDescription: "UUID of the data domain this product belongs to",
 				Computed:            true,
 			},
-			"data_assets": schema.ListNestedAttribute{
-				MarkdownDescription: "List of data assets associated with this data product",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							MarkdownDescription: "Type of the data asset (DATASET, TABLE)",
-							Computed:            true,
-						},
-						"uuid": schema.StringAttribute{
-							MarkdownDescription: "UUID of the data asset",
-							Computed:            true,
-						},
-						"project": schema.StringAttribute{
-							MarkdownDescription: "Project of the data asset",
-							Computed:            true,
-						},
-						"dataset": schema.StringAttribute{
-							MarkdownDescription: "Dataset of the data asset",
-							Computed:            true,
-						},
-						"table": schema.StringAttribute{
-							MarkdownDescription: "Table of the data asset",
-							Computed:            true,
-						},
-						"alert_type": schema.StringAttribute{
-							MarkdownDescription: "Alert type of the data asset (e.g., DATASET, TABLE)",
-							Computed:            true,
-						},
-					},
-				},
-			},
+			"data_assets": dataProductAssetsDataSourceAttribute(),
 		},
 	}
 }
@@ -88,11 +57,11 @@ func (d *DataProductDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -111,13 +80,14 @@ func (d *DataProductDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Get the data product from Masthead API
-	productResponse, err := d.client.GetDataProduct(config.UUID.ValueString())
+	productResponse, err := d.client.GetDataProduct(ctx, config.UUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data product, got error: %s", err))
 		return
 	}
 
 	// Map response body to model
+	state.UUID = types.StringValue(productResponse.UUID)
 	state.Name = types.StringValue(productResponse.Name)
 	if productResponse.Description == "" {
 		state.Description = types.StringNull()
@@ -139,7 +109,6 @@ func (d *DataProductDataSource) Read(ctx context.Context, req datasource.ReadReq
 			mappedAsset.UUID = types.StringValue(asset.UUID)
 			mappedAsset.Project = types.StringValue(asset.Project)
 			mappedAsset.Dataset = types.StringValue(asset.Dataset)
-			mappedAsset.Table = types.StringValue(asset.Table)
 			if asset.Table == "" {
 				mappedAsset.Table = types.StringNull()
 			} else {
@@ -158,3 +127,40 @@ func (d *DataProductDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// dataProductAssetsDataSourceAttribute returns the computed data_assets
+// attribute of data product data sources
+func dataProductAssetsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "List of data assets associated with this data product",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of the data asset (DATASET, TABLE)",
+					Computed:            true,
+				},
+				"uuid": schema.StringAttribute{
+					MarkdownDescription: "UUID of the data asset",
+					Computed:            true,
+				},
+				"project": schema.StringAttribute{
+					MarkdownDescription: "Project of the data asset",
+					Computed:            true,
+				},
+				"dataset": schema.StringAttribute{
+					MarkdownDescription: "Dataset of the data asset",
+					Computed:            true,
+				},
+				"table": schema.StringAttribute{
+					MarkdownDescription: "Table of the data asset",
+					Computed:            true,
+				},
+				"alert_type": schema.StringAttribute{
+					MarkdownDescription: "Alert type of the data asset (e.g., DATASET, TABLE)",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataProductDataSource(t *testing.T) {
+	server := testAccServer(t)
+	domain := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com"})
+	product := server.AddDataProduct(fakeapi.DataProduct{
+		Name:           "Revenue",
+		DataDomainUUID: domain.UUID,
+		DataAssets: []fakeapi.DataProductAsset{
+			{Type: "TABLE", Project: "analytics", Dataset: "finance", Table: "invoices"},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "masthead_data_product" "test" {
+  uuid = %q
+}
+`, product.UUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_data_product.test", tfjsonpath.New("uuid"), knownvalue.StringExact(product.UUID)),
+					statecheck.ExpectKnownValue("data.masthead_data_product.test", tfjsonpath.New("name"), knownvalue.StringExact("Revenue")),
+					statecheck.ExpectKnownValue("data.masthead_data_product.test", tfjsonpath.New("description"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("data.masthead_data_product.test", tfjsonpath.New("data_domain_uuid"), knownvalue.StringExact(domain.UUID)),
+					statecheck.ExpectKnownValue("data.masthead_data_product.test", tfjsonpath.New("data_assets"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"type":       knownvalue.StringExact("TABLE"),
+							"uuid":       knownvalue.StringExact(product.DataAssets[0].UUID),
+							"project":    knownvalue.StringExact("analytics"),
+							"dataset":    knownvalue.StringExact("finance"),
+							"table":      knownvalue.StringExact("invoices"),
+							"alert_type": knownvalue.StringExact("REGULAR"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResourceWithConfigure = &DataProductListResource{}
+
+func NewDataProductListResource() list.ListResource {
+	return &DataProductListResource{}
+}
+
+// DataProductListResource lists the data products of the account, e.g. for terraform query.
+type DataProductListResource struct {
+	client masthead.MastheadAPI
+}
+
+// DataProductListResourceModel describes the list configuration.
+type DataProductListResourceModel struct {
+	NamePrefix     types.String `tfsdk:"name_prefix"`
+	DataDomainUUID types.String `tfsdk:"data_domain_uuid"`
+}
+
+func (r *DataProductListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_product"
+}
+
+func (r *DataProductListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists Masthead data products",
+		Attributes: map[string]listschema.Attribute{
+			"name_prefix": schemaNamePrefixAttribute("data products"),
+			"data_domain_uuid": listschema.StringAttribute{
+				MarkdownDescription: "Only list the data products of the data domain with this UUID",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *DataProductListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DataProductListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config DataProductListResourceModel
+	var diags diag.Diagnostics
+
+	// The config block of list blocks is optional
+	if !req.Config.Raw.IsNull() {
+		diags.Append(req.Config.Get(ctx, &config)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	match := func(product masthead.DataProduct) bool {
+		if !strings.HasPrefix(product.Name, config.NamePrefix.ValueString()) {
+			return false
+		}
+		return config.DataDomainUUID.IsNull() || dataProductDomainUUID(product) == config.DataDomainUUID.ValueString()
+	}
+
+	stream.Results = listResults(ctx, req, "data products", r.client.IterDataProducts(ctx), match, func(product masthead.DataProduct, result *list.ListResult) {
+		result.DisplayName = dataProductDisplayName(product)
+		result.Diagnostics.Append(result.Identity.Set(ctx, DataProductResourceIdentityModel{UUID: types.StringValue(product.UUID)})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, dataProductsEntryModel(product))...)
+		}
+	})
+}
+
+// dataProductDisplayName names a data product after its data domain when the
+// API returns it, like imports by name do: "<domain name>/<product name>"
+func dataProductDisplayName(product masthead.DataProduct) string {
+	if product.DataDomain != nil && product.DataDomain.Name != "" {
+		return product.DataDomain.Name + "/" + product.Name
+	}
+	return product.Name
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataProductListResource(t *testing.T) {
+	server := testAccServer(t)
+	sales := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com"})
+	finance := server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance@example.com"})
+	revenue := server.AddDataProduct(fakeapi.DataProduct{
+		Name:           "Revenue",
+		DataDomainUUID: sales.UUID,
+		Description:    "Revenue reporting",
+		DataAssets: []fakeapi.DataProductAsset{
+			{Type: "DATASET", Project: "analytics", Dataset: "revenue"},
+		},
+	})
+	server.AddDataProduct(fakeapi.DataProduct{Name: "Pipeline", DataDomainUUID: sales.UUID})
+	financeRevenue := server.AddDataProduct(fakeapi.DataProduct{Name: "Revenue", DataDomainUUID: finance.UUID})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The provider configuration of the first step is reused by query steps
+			{
+				Config: testAccProviderConfig(server),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+list "masthead_data_product" "all" {
+  provider = masthead
+}
+
+list "masthead_data_product" "sales_revenue" {
+  provider         = masthead
+  include_resource = true
+
+  config {
+    name_prefix      = "Rev"
+    data_domain_uuid = %q
+  }
+}
+`, sales.UUID),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("masthead_data_product.all", 3),
+					querycheck.ExpectLength("masthead_data_product.sales_revenue", 1),
+					querycheck.ExpectNoIdentity("masthead_data_product.sales_revenue", map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(financeRevenue.UUID),
+					}),
+					querycheck.ExpectResourceDisplayName("masthead_data_product.sales_revenue", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(revenue.UUID),
+					}), knownvalue.StringExact("Sales/Revenue")),
+					querycheck.ExpectResourceKnownValues("masthead_data_product.sales_revenue", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"uuid": knownvalue.StringExact(revenue.UUID),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("data_domain_uuid"), KnownValue: knownvalue.StringExact(sales.UUID)},
+						{Path: tfjsonpath.New("description"), KnownValue: knownvalue.StringExact("Revenue reporting")},
+						{Path: tfjsonpath.New("data_assets").AtSliceIndex(0).AtMapKey("dataset"), KnownValue: knownvalue.StringExact("revenue")},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestDataProductListResourceList(t *testing.T) {
+	products := []masthead.DataProduct{
+		{UUID: "p1", Name: "Revenue", DataDomain: &masthead.DataDomain{UUID: "d1", Name: "Sales"}},
+		{UUID: "p2", Name: "Pipeline", DataDomainUUID: "d1"},
+		{UUID: "p3", Name: "Revenue", DataDomainUUID: "d2"},
+	}
+	var consumed int
+	api := &mockAPI{
+		IterDataProductsFunc: func(ctx context.Context) iter.Seq2[masthead.DataProduct, error] {
+			consumed = 0
+			return seqOf(&consumed, products...)
+		},
+	}
+	r := configuredListResource(t, &DataProductListResource{}, api)
+
+	results := listResourceResults(t, r, &DataProductResource{}, DataProductListResourceModel{
+		NamePrefix:     types.StringNull(),
+		DataDomainUUID: types.StringValue("d1"),
+	}, 0, true)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "Sales/Revenue", results[0].DisplayName)
+		assert.Equal(t, "Pipeline", results[1].DisplayName)
+		var identity DataProductResourceIdentityModel
+		results[1].Identity.Get(context.Background(), &identity)
+		assert.Equal(t, "p2", identity.UUID.ValueString())
+		var model DataProductResourceModel
+		results[1].Resource.Get(context.Background(), &model)
+		assert.Equal(t, dataProductsEntryModel(products[1]), model)
+		assert.Equal(t, types.StringValue("d1"), model.DataDomainUUID)
+	}
+
+	results = listResourceResults(t, r, &DataProductResource{}, DataProductListResourceModel{
+		NamePrefix:     types.StringValue("Rev"),
+		DataDomainUUID: types.StringNull(),
+	}, 1, false)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "Sales/Revenue", results[0].DisplayName)
+	}
+	assert.Equal(t, 1, consumed, "No data product should be read past the limit")
+}
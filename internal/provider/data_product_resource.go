@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -16,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &DataProductResource{}
 var _ resource.ResourceWithImportState = &DataProductResource{}
+var _ resource.ResourceWithIdentity = &DataProductResource{}
 
 func NewDataProductResource() resource.Resource {
 	return &DataProductResource{}
@@ -23,7 +26,7 @@ func NewDataProductResource() resource.Resource {
 
 // DataProductResource defines the resource implementation.
 type DataProductResource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
 }
 
 // DataProductAssetResourceModel describes a data asset in the resource model
@@ -45,6 +48,11 @@ type DataProductResourceModel struct {
 	DataAssets     []DataProductAssetResourceModel `tfsdk:"data_assets"`
 }
 
+// DataProductResourceIdentityModel describes the identity of the resource.
+type DataProductResourceIdentityModel struct {
+	UUID types.String `tfsdk:"uuid"`
+}
+
 func (r *DataProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_data_product"
 }
@@ -108,17 +116,28 @@ func (r *DataProductResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+func (r *DataProductResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"uuid": identityschema.StringAttribute{
+				Description:       "UUID of the data product",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *DataProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -157,53 +176,18 @@ func (r *DataProductResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	productResponse, err := r.client.CreateDataProduct(productRequest)
+	productResponse, err := r.client.CreateDataProduct(ctx, productRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create data product, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(productResponse.UUID)
-	state.Name = types.StringValue(productResponse.Name)
-	if productResponse.Description == "" {
-		state.Description = types.StringNull()
-	} else {
-		state.Description = types.StringValue(productResponse.Description)
-	}
-	if productResponse.DataDomain != nil {
-		state.DataDomainUUID = types.StringValue(productResponse.DataDomain.UUID)
-	} else {
-		state.DataDomainUUID = types.StringNull()
-	}
-
-	// Map data assets
-	if len(productResponse.DataAssets) > 0 {
-		dataAssets := make([]DataProductAssetResourceModel, 0, len(productResponse.DataAssets))
-		for _, asset := range productResponse.DataAssets {
-			var mappedAsset DataProductAssetResourceModel
-			mappedAsset.Type = asset.Type
-			mappedAsset.UUID = types.StringValue(asset.UUID)
-			mappedAsset.Project = types.StringValue(asset.Project)
-			mappedAsset.Dataset = types.StringValue(asset.Dataset)
-			mappedAsset.Table = types.StringValue(asset.Table)
-			if asset.Table == "" {
-				mappedAsset.Table = types.StringNull()
-			} else {
-				mappedAsset.Table = types.StringValue(asset.Table)
-			}
-			mappedAsset.AlertType = types.StringValue(string(asset.AlertType))
-
-			// Add the mapped asset to the list
-			dataAssets = append(dataAssets, mappedAsset)
-		}
-		state.DataAssets = dataAssets
-	} else {
-		state.DataAssets = []DataProductAssetResourceModel{}
-	}
+	state = dataProductResourceModel(*productResponse)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataProductResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -217,46 +201,22 @@ func (r *DataProductResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get data product by UUID
-	productResponse, err := r.client.GetDataProduct(plan.UUID.ValueString())
-	if err != nil {
+	productResponse, err := r.client.GetDataProduct(ctx, plan.UUID.ValueString())
+	if masthead.IsNotFound(err) {
+		// The data product was deleted outside of Terraform, remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data product, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(productResponse.UUID)
-	state.Name = types.StringValue(productResponse.Name)
-	if productResponse.Description == "" {
-		state.Description = types.StringNull()
-	} else {
-		state.Description = types.StringValue(productResponse.Description)
-	}
-	if productResponse.DataDomain != nil {
-		state.DataDomainUUID = types.StringValue(productResponse.DataDomain.UUID)
-	} else {
-		state.DataDomainUUID = types.StringNull()
-	}
-
-	// Map data assets
-	if len(productResponse.DataAssets) > 0 {
-		dataAssets := make([]DataProductAssetResourceModel, 0, len(productResponse.DataAssets))
-		for _, asset := range productResponse.DataAssets {
-			dataAssets = append(dataAssets, DataProductAssetResourceModel{
-				Type:      asset.Type,
-				UUID:      types.StringValue(asset.UUID),
-				Project:   types.StringValue(asset.Project),
-				Dataset:   types.StringValue(asset.Dataset),
-				Table:     types.StringValue(asset.Table),
-				AlertType: types.StringValue(string(asset.AlertType)),
-			})
-		}
-		state.DataAssets = dataAssets
-	} else {
-		state.DataAssets = []DataProductAssetResourceModel{}
-	}
+	state = dataProductResourceModel(*productResponse)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataProductResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -292,53 +252,18 @@ func (r *DataProductResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	productResponse, err := r.client.UpdateDataProduct(productRequest)
+	productResponse, err := r.client.UpdateDataProduct(ctx, productRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update data product, got error: %s", err))
 		return
 	}
 
 	// Map response to model
-	state.UUID = types.StringValue(productResponse.UUID)
-	state.Name = types.StringValue(productResponse.Name)
-	if productResponse.Description == "" {
-		state.Description = types.StringNull()
-	} else {
-		state.Description = types.StringValue(productResponse.Description)
-	}
-	if productResponse.DataDomain != nil {
-		state.DataDomainUUID = types.StringValue(productResponse.DataDomain.UUID)
-	} else {
-		state.DataDomainUUID = types.StringNull()
-	}
-
-	// Map data assets
-	if len(productResponse.DataAssets) > 0 {
-		dataAssets := make([]DataProductAssetResourceModel, 0, len(productResponse.DataAssets))
-		for _, asset := range productResponse.DataAssets {
-			var mappedAsset DataProductAssetResourceModel
-			mappedAsset.Type = asset.Type
-			mappedAsset.UUID = types.StringValue(asset.UUID)
-			mappedAsset.Project = types.StringValue(asset.Project)
-			mappedAsset.Dataset = types.StringValue(asset.Dataset)
-			mappedAsset.Table = types.StringValue(asset.Table)
-			if asset.Table == "" {
-				mappedAsset.Table = types.StringNull()
-			} else {
-				mappedAsset.Table = types.StringValue(asset.Table)
-			}
-			mappedAsset.AlertType = types.StringValue(string(asset.AlertType))
-
-			// Add the mapped asset to the list
-			dataAssets = append(dataAssets, mappedAsset)
-		}
-		state.DataAssets = dataAssets
-	} else {
-		state.DataAssets = []DataProductAssetResourceModel{}
-	}
+	state = dataProductResourceModel(*productResponse)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, DataProductResourceIdentityModel{UUID: state.UUID})...)
 }
 
 func (r *DataProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -351,13 +276,77 @@ func (r *DataProductResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	// Delete data product
-	err := r.client.DeleteDataProduct(state.UUID.ValueString())
-	if err != nil {
+	err := r.client.DeleteDataProduct(ctx, state.UUID.ValueString())
+	if err != nil && !masthead.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete data product, got error: %s", err))
 		return
 	}
 }
 
+// ImportState accepts the UUID of the data product, as import ID or identity,
+// or its name and the name of its data domain as "name:<domain name>/<product name>"
 func (r *DataProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+	names, byName := strings.CutPrefix(req.ID, importNamePrefix)
+	if !byName {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("uuid"), path.Root("uuid"), req, resp)
+		return
+	}
+
+	// The data domain name ends at the first slash, data product names can contain slashes
+	domainName, name, ok := strings.Cut(names, "/")
+	if !ok || domainName == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the UUID of a data product or \"name:<domain name>/<product name>\", got %q.", req.ID),
+		)
+		return
+	}
+
+	product := lookupDataProductByName(ctx, r.client, domainName, name, &resp.Diagnostics)
+	if product == nil {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), product.UUID)...)
+}
+
+// dataProductResourceModel maps a data product returned by the API to the resource model
+func dataProductResourceModel(product masthead.DataProduct) DataProductResourceModel {
+	var state DataProductResourceModel
+
+	state.UUID = types.StringValue(product.UUID)
+	state.Name = types.StringValue(product.Name)
+	if product.Description == "" {
+		state.Description = types.StringNull()
+	} else {
+		state.Description = types.StringValue(product.Description)
+	}
+	if product.DataDomain != nil {
+		state.DataDomainUUID = types.StringValue(product.DataDomain.UUID)
+	} else {
+		state.DataDomainUUID = types.StringNull()
+	}
+
+	// Map data assets
+	if len(product.DataAssets) > 0 {
+		dataAssets := make([]DataProductAssetResourceModel, 0, len(product.DataAssets))
+		for _, asset := range product.DataAssets {
+			mappedAsset := DataProductAssetResourceModel{
+				Type:      asset.Type,
+				UUID:      types.StringValue(asset.UUID),
+				Project:   types.StringValue(asset.Project),
+				Dataset:   types.StringValue(asset.Dataset),
+				Table:     types.StringNull(),
+				AlertType: types.StringValue(string(asset.AlertType)),
+			}
+			if asset.Table != "" {
+				mappedAsset.Table = types.StringValue(asset.Table)
+			}
+			dataAssets = append(dataAssets, mappedAsset)
+		}
+		state.DataAssets = dataAssets
+	} else {
+		state.DataAssets = []DataProductAssetResourceModel{}
+	}
+
+	return state
 }
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataProductResource(t *testing.T) {
+	server := testAccServer(t)
+	domain := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com"})
+	var productUUID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDataProductResourceConfig(domain.UUID, "Revenue", `
+  data_assets = [
+    {
+      type    = "DATASET"
+      project = "analytics"
+      dataset = "revenue"
+    },
+  ]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("masthead_data_product.test", tfjsonpath.New("uuid")),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("uuid"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("name"), knownvalue.StringExact("Revenue")),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("data_domain_uuid"), knownvalue.StringExact(domain.UUID)),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("data_assets").AtSliceIndex(0).AtMapKey("table"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("data_assets").AtSliceIndex(0).AtMapKey("alert_type"), knownvalue.StringExact("REGULAR")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					productUUID = s.RootModule().Resources["masthead_data_product.test"].Primary.Attributes["uuid"]
+					return nil
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "masthead_data_product.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccAttributeImportStateID("masthead_data_product.test", "uuid"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+			// Import by identity, with an import block
+			{
+				ResourceName:    "masthead_data_product.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Import by data domain and data product names
+			{
+				ResourceName:                         "masthead_data_product.test",
+				ImportState:                          true,
+				ImportStateId:                        "name:Sales/Revenue",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+			},
+			{
+				ResourceName:  "masthead_data_product.test",
+				ImportState:   true,
+				ImportStateId: "name:Revenue",
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
+			// Update and Read testing, adding a table asset
+			{
+				Config: testAccProviderConfig(server) + testAccDataProductResourceConfig(domain.UUID, "Revenue reporting", `
+  description = "Monthly revenue"
+  data_assets = [
+    {
+      type    = "DATASET"
+      project = "analytics"
+      dataset = "revenue"
+    },
+    {
+      type    = "TABLE"
+      project = "analytics"
+      dataset = "finance"
+      table   = "invoices"
+    },
+  ]
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_data_product.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("name"), knownvalue.StringExact("Revenue reporting")),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("description"), knownvalue.StringExact("Monthly revenue")),
+					statecheck.ExpectKnownValue("masthead_data_product.test", tfjsonpath.New("data_assets").AtSliceIndex(1).AtMapKey("table"), knownvalue.StringExact("invoices")),
+				},
+			},
+			// Drift testing: the data product is recreated after being deleted outside of Terraform
+			{
+				PreConfig: func() {
+					server.RemoveDataProduct(productUUID)
+				},
+				Config: testAccProviderConfig(server) + testAccDataProductResourceConfig(domain.UUID, "Revenue reporting", `
+  description = "Monthly revenue"
+  data_assets = [
+    {
+      type    = "DATASET"
+      project = "analytics"
+      dataset = "revenue"
+    },
+  ]
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_data_product.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if _, ok := server.DataProduct(rs.Primary.Attributes["uuid"]); ok {
+					return fmt.Errorf("data product %s still exists", rs.Primary.Attributes["uuid"])
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func testAccDataProductResourceConfig(domainUUID, name, body string) string {
+	return fmt.Sprintf(`
+resource "masthead_data_product" "test" {
+  name             = %[2]q
+  data_domain_uuid = %[1]q
+%[3]s}
+`, domainUUID, name, body)
+}
+
+func TestDataProductResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	api := &mockAPI{
+		CreateDataProductFunc: func(ctx context.Context, dataProduct masthead.DataProduct) (*masthead.DataProduct, error) {
+			assert.Equal(t, "d1", dataProduct.DataDomainUUID)
+			assert.Equal(t, []masthead.DataProductAsset{
+				{Type: masthead.DataProductAssetTypeDataset, Project: "analytics", Dataset: "revenue"},
+				{Type: masthead.DataProductAssetTypeTable, Project: "analytics", Dataset: "finance", Table: "invoices"},
+			}, dataProduct.DataAssets)
+
+			dataProduct.UUID = "p1"
+			dataProduct.DataDomain = &masthead.DataDomain{UUID: dataProduct.DataDomainUUID}
+			for i := range dataProduct.DataAssets {
+				dataProduct.DataAssets[i].UUID = fmt.Sprintf("a%d", i)
+				dataProduct.DataAssets[i].AlertType = "REGULAR"
+			}
+			return &dataProduct, nil
+		},
+	}
+	r := configuredResource(t, &DataProductResource{}, api)
+
+	plan := DataProductResourceModel{
+		UUID:           types.StringUnknown(),
+		Name:           types.StringValue("Revenue"),
+		Description:    types.StringNull(),
+		DataDomainUUID: types.StringValue("d1"),
+		DataAssets: []DataProductAssetResourceModel{
+			{Type: masthead.DataProductAssetTypeDataset, UUID: types.StringUnknown(), Project: types.StringValue("analytics"), Dataset: types.StringValue("revenue"), Table: types.StringNull(), AlertType: types.StringUnknown()},
+			{Type: masthead.DataProductAssetTypeTable, UUID: types.StringUnknown(), Project: types.StringValue("analytics"), Dataset: types.StringValue("finance"), Table: types.StringValue("invoices"), AlertType: types.StringUnknown()},
+		},
+	}
+	resp := fwresource.CreateResponse{State: resourceState(t, r, nil), Identity: resourceIdentity(t, r)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: resourcePlan(t, r, &plan)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state DataProductResourceModel
+	resp.State.Get(ctx, &state)
+	assert.Equal(t, types.StringValue("p1"), state.UUID)
+	assert.True(t, state.Description.IsNull(), "Empty description should map to null")
+	assert.True(t, state.DataAssets[0].Table.IsNull(), "Dataset assets should have a null table")
+	assert.Equal(t, types.StringValue("invoices"), state.DataAssets[1].Table)
+	assert.Equal(t, types.StringValue("a1"), state.DataAssets[1].UUID)
+	assert.Equal(t, types.StringValue("REGULAR"), state.DataAssets[1].AlertType)
+}
+
+func TestDataProductResourceImportState(t *testing.T) {
+	api := &mockAPI{
+		ListDomainsFunc: func(ctx context.Context) ([]masthead.DataDomain, error) {
+			return []masthead.DataDomain{{UUID: "d1", Name: "Sales"}, {UUID: "d2", Name: "Finance"}}, nil
+		},
+		ListDataProductsFunc: func(ctx context.Context) ([]masthead.DataProduct, error) {
+			return []masthead.DataProduct{
+				{UUID: "p1", Name: "Revenue", DataDomain: &masthead.DataDomain{UUID: "d1"}},
+				{UUID: "p2", Name: "Revenue", DataDomain: &masthead.DataDomain{UUID: "d2"}},
+				{UUID: "p3", Name: "Costs/2025", DataDomain: &masthead.DataDomain{UUID: "d2"}},
+				{UUID: "p4", Name: "Costs/2025", DataDomainUUID: "d2"},
+			}, nil
+		},
+	}
+	r := configuredResource(t, &DataProductResource{}, api)
+
+	importState := func(id string) fwresource.ImportStateResponse {
+		resp := fwresource.ImportStateResponse{State: resourceState(t, r, nil)}
+		r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+
+	resp := importState("name:Finance/Revenue")
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	var uuid types.String
+	resp.State.GetAttribute(context.Background(), path.Root("uuid"), &uuid)
+	assert.Equal(t, "p2", uuid.ValueString(), "Products should be matched within their data domain")
+
+	resp = importState("name:Finance/Costs/2025")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Ambiguous Data Product Name", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "p3, p4")
+	}
+
+	resp = importState("name:Sales/Costs/2025")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Data Product Not Found", resp.Diagnostics[0].Summary())
+	}
+
+	resp = importState("name:Marketing/Revenue")
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Equal(t, "Data Domain Not Found", resp.Diagnostics[0].Summary())
+	}
+}
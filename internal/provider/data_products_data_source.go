@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &DataProductsDataSource{}
+
+func NewDataProductsDataSource() datasource.DataSource {
+	return &DataProductsDataSource{}
+}
+
+// DataProductsDataSource defines the data source implementation.
+type DataProductsDataSource struct {
+	client masthead.MastheadAPI
+}
+
+// DataProductsDataSourceModel describes the data source data model.
+type DataProductsDataSourceModel struct {
+	NameRegex      types.String               `tfsdk:"name_regex"`
+	DataDomainUUID types.String               `tfsdk:"data_domain_uuid"`
+	AssetProject   types.String               `tfsdk:"asset_project"`
+	AssetDataset   types.String               `tfsdk:"asset_dataset"`
+	DataProducts   []DataProductResourceModel `tfsdk:"data_products"`
+}
+
+func (d *DataProductsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_products"
+}
+
+func (d *DataProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the Masthead data products matching the filters, sorted by name, data domain UUID and UUID",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return data products whose name matches this [regular expression](https://pkg.go.dev/regexp/syntax)",
+				Optional:            true,
+			},
+			"data_domain_uuid": schema.StringAttribute{
+				MarkdownDescription: "Only return the data products of the data domain with this UUID",
+				Optional:            true,
+			},
+			"asset_project": schema.StringAttribute{
+				MarkdownDescription: "Only return data products with a data asset in this project",
+				Optional:            true,
+			},
+			"asset_dataset": schema.StringAttribute{
+				MarkdownDescription: "Only return data products with a data asset in this dataset. Combined with `asset_project`, both must match the same data asset.",
+				Optional:            true,
+			},
+			"data_products": schema.ListNestedAttribute{
+				MarkdownDescription: "Data products matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "UUID of the data product",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the data product",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the data product",
+							Computed:            true,
+						},
+						"data_domain_uuid": schema.StringAttribute{
+							MarkdownDescription: "UUID of the data domain this product belongs to",
+							Computed:            true,
+						},
+						"data_assets": dataProductAssetsDataSourceAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataProductsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataProductsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	nameRegex := compileRegexFilter(path.Root("name_regex"), state.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	productsResponse, err := d.client.ListDataProducts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read data products, got error: %s", err))
+		return
+	}
+
+	state.DataProducts = []DataProductResourceModel{}
+	for _, product := range productsResponse {
+		if !matchesRegexFilter(nameRegex, product.Name) {
+			continue
+		}
+		if !state.DataDomainUUID.IsNull() && dataProductDomainUUID(product) != state.DataDomainUUID.ValueString() {
+			continue
+		}
+		if !hasMatchingDataAsset(product, state.AssetProject, state.AssetDataset) {
+			continue
+		}
+		state.DataProducts = append(state.DataProducts, dataProductsEntryModel(product))
+	}
+	slices.SortFunc(state.DataProducts, func(a, b DataProductResourceModel) int {
+		return cmp.Or(
+			cmp.Compare(a.Name.ValueString(), b.Name.ValueString()),
+			cmp.Compare(a.DataDomainUUID.ValueString(), b.DataDomainUUID.ValueString()),
+			cmp.Compare(a.UUID.ValueString(), b.UUID.ValueString()),
+		)
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// dataProductsEntryModel maps a data product to an entry of data_products, or
+// to a result of the data product list resource. The data domain UUID falls
+// back to the dataDomainUuid field like the data_domain_uuid filters do, so
+// that matching entries never have a null data_domain_uuid.
+func dataProductsEntryModel(product masthead.DataProduct) DataProductResourceModel {
+	model := dataProductResourceModel(product)
+	if domainUUID := dataProductDomainUUID(product); domainUUID != "" {
+		model.DataDomainUUID = types.StringValue(domainUUID)
+	}
+	return model
+}
+
+// hasMatchingDataAsset reports whether one of the data assets of the product
+// is in the given project and dataset, where null filters match any value
+func hasMatchingDataAsset(product masthead.DataProduct, project, dataset types.String) bool {
+	if project.IsNull() && dataset.IsNull() {
+		return true
+	}
+
+	return slices.ContainsFunc(product.DataAssets, func(asset masthead.DataProductAsset) bool {
+		return (project.IsNull() || asset.Project == project.ValueString()) &&
+			(dataset.IsNull() || asset.Dataset == dataset.ValueString())
+	})
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccDataProductsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	sales := server.AddDomain(fakeapi.DataDomain{Name: "Sales", Email: "sales@example.com"})
+	finance := server.AddDomain(fakeapi.DataDomain{Name: "Finance", Email: "finance@example.com"})
+	pipeline := server.AddDataProduct(fakeapi.DataProduct{
+		Name:           "Pipeline",
+		DataDomainUUID: sales.UUID,
+		DataAssets: []fakeapi.DataProductAsset{
+			{Type: "DATASET", Project: "crm", Dataset: "opportunities"},
+		},
+	})
+	revenue := server.AddDataProduct(fakeapi.DataProduct{
+		Name:           "Revenue",
+		DataDomainUUID: sales.UUID,
+		DataAssets: []fakeapi.DataProductAsset{
+			{Type: "TABLE", Project: "analytics", Dataset: "finance", Table: "invoices"},
+		},
+	})
+	server.AddDataProduct(fakeapi.DataProduct{
+		Name:           "Revenue",
+		DataDomainUUID: finance.UUID,
+		DataAssets: []fakeapi.DataProductAsset{
+			{Type: "DATASET", Project: "analytics", Dataset: "ledger"},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "masthead_data_products" "all" {}
+
+data "masthead_data_products" "sales" {
+  data_domain_uuid = %q
+}
+
+data "masthead_data_products" "invoices" {
+  name_regex    = "^Rev"
+  asset_project = "analytics"
+  asset_dataset = "finance"
+}
+`, sales.UUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_data_products.all", tfjsonpath.New("data_products"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("data.masthead_data_products.sales", tfjsonpath.New("data_products"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"uuid": knownvalue.StringExact(pipeline.UUID),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"uuid": knownvalue.StringExact(revenue.UUID),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.masthead_data_products.invoices", tfjsonpath.New("data_products"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"uuid":             knownvalue.StringExact(revenue.UUID),
+							"name":             knownvalue.StringExact("Revenue"),
+							"description":      knownvalue.Null(),
+							"data_domain_uuid": knownvalue.StringExact(sales.UUID),
+							"data_assets": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"type":       knownvalue.StringExact("TABLE"),
+									"uuid":       knownvalue.StringExact(revenue.DataAssets[0].UUID),
+									"project":    knownvalue.StringExact("analytics"),
+									"dataset":    knownvalue.StringExact("finance"),
+									"table":      knownvalue.StringExact("invoices"),
+									"alert_type": knownvalue.StringExact("REGULAR"),
+								}),
+							}),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestDataProductsEntryModel(t *testing.T) {
+	nested := dataProductsEntryModel(masthead.DataProduct{UUID: "p1", Name: "Revenue", DataDomain: &masthead.DataDomain{UUID: "d1"}})
+	assert.Equal(t, types.StringValue("d1"), nested.DataDomainUUID)
+
+	flat := dataProductsEntryModel(masthead.DataProduct{UUID: "p2", Name: "Pipeline", DataDomainUUID: "d2"})
+	assert.Equal(t, types.StringValue("d2"), flat.DataDomainUUID)
+
+	orphan := dataProductsEntryModel(masthead.DataProduct{UUID: "p3", Name: "Orphan"})
+	assert.Equal(t, types.StringNull(), orphan.DataDomainUUID)
+}
+
+func TestHasMatchingDataAsset(t *testing.T) {
+	product := masthead.DataProduct{
+		DataAssets: []masthead.DataProductAsset{
+			{Project: "analytics", Dataset: "finance"},
+			{Project: "crm", Dataset: "opportunities"},
+		},
+	}
+
+	assert.True(t, hasMatchingDataAsset(product, types.StringNull(), types.StringNull()))
+	assert.True(t, hasMatchingDataAsset(product, types.StringValue("crm"), types.StringNull()))
+	assert.True(t, hasMatchingDataAsset(product, types.StringNull(), types.StringValue("finance")))
+	assert.True(t, hasMatchingDataAsset(product, types.StringValue("analytics"), types.StringValue("finance")))
+	assert.False(t, hasMatchingDataAsset(product, types.StringValue("analytics"), types.StringValue("opportunities")))
+	assert.False(t, hasMatchingDataAsset(masthead.DataProduct{}, types.StringValue("analytics"), types.StringNull()))
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// compileRegexFilter compiles the regular expression of a filter attribute,
+// reporting invalid expressions on the attribute. It returns nil when the
+// filter is not set.
+func compileRegexFilter(attribute path.Path, value types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value %q is not a valid regular expression: %s", value.ValueString(), err),
+		)
+		return nil
+	}
+	return re
+}
+
+// matchesRegexFilter reports whether s matches the filter, where a nil filter
+// matches everything
+func matchesRegexFilter(re *regexp.Regexp, s string) bool {
+	return re == nil || re.MatchString(s)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+)
+
+// listResults streams one list result per item matching the filter, up to the
+// limit of the request. Items are read from the API as results are consumed,
+// so that no further pages are fetched once the limit is reached or Terraform
+// stops reading. setResult fills in the identity, display name and, when
+// requested, the resource of each result. An API error is streamed as a last
+// result holding the diagnostic.
+func listResults[T any](ctx context.Context, req list.ListRequest, objects string, items iter.Seq2[T, error], match func(item T) bool, setResult func(item T, result *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range items {
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("Client Error", fmt.Sprintf("Unable to list %s, got error: %s", objects, err))
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			if !match(item) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			setResult(item, &result)
+			if !push(result) {
+				return
+			}
+
+			count++
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
+
+// schemaNamePrefixAttribute returns the name_prefix filter of list resources
+func schemaNamePrefixAttribute(objects string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only list %s whose name starts with this prefix. Case sensitive.", objects),
+		Optional:            true,
+	}
+}
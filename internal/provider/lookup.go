@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// importNamePrefix - Prefix of import IDs referring to objects by name rather than UUID
+const importNamePrefix = "name:"
+
+// lookupDataDomainByName returns the only data domain with the given name,
+// reporting missing and ambiguous matches in diags
+func lookupDataDomainByName(ctx context.Context, client masthead.MastheadAPI, name string, diags *diag.Diagnostics) *masthead.DataDomain {
+	domains, err := client.ListDomains(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list data domains, got error: %s", err))
+		return nil
+	}
+
+	var matches []masthead.DataDomain
+	for _, domain := range domains {
+		if domain.Name == name {
+			matches = append(matches, domain)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"Data Domain Not Found",
+			fmt.Sprintf("No data domain is named %q. Names are case sensitive.", name),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+
+	uuids := make([]string, len(matches))
+	for i, domain := range matches {
+		uuids[i] = domain.UUID
+	}
+	diags.AddError(
+		"Ambiguous Data Domain Name",
+		fmt.Sprintf("%d data domains are named %q, with UUIDs %s. Use the UUID of the intended data domain instead.",
+			len(matches), name, strings.Join(uuids, ", ")),
+	)
+	return nil
+}
+
+// lookupDataProductByName returns the only data product with the given name
+// in the data domain with the given name, reporting missing and ambiguous
+// matches in diags
+func lookupDataProductByName(ctx context.Context, client masthead.MastheadAPI, domainName, name string, diags *diag.Diagnostics) *masthead.DataProduct {
+	domain := lookupDataDomainByName(ctx, client, domainName, diags)
+	if domain == nil {
+		return nil
+	}
+
+	products, err := client.ListDataProducts(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list data products, got error: %s", err))
+		return nil
+	}
+
+	var matches []masthead.DataProduct
+	for _, product := range products {
+		if product.Name == name && dataProductDomainUUID(product) == domain.UUID {
+			matches = append(matches, product)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"Data Product Not Found",
+			fmt.Sprintf("No data product is named %q in the data domain %q. Names are case sensitive.", name, domainName),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+
+	uuids := make([]string, len(matches))
+	for i, product := range matches {
+		uuids[i] = product.UUID
+	}
+	diags.AddError(
+		"Ambiguous Data Product Name",
+		fmt.Sprintf("%d data products are named %q in the data domain %q, with UUIDs %s. Use the UUID of the intended data product instead.",
+			len(matches), name, domainName, strings.Join(uuids, ", ")),
+	)
+	return nil
+}
+
+// dataProductDomainUUID returns the UUID of the data domain of a data product,
+// from the nested domain or, when missing, the dataDomainUuid field
+func dataProductDomainUUID(product masthead.DataProduct) string {
+	if product.DataDomain != nil {
+		return product.DataDomain.UUID
+	}
+	return product.DataDomainUUID
+}
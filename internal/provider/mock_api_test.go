@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// mockAPI is a handwritten masthead.MastheadAPI for unit tests. Each operation
+// calls the matching function field, and fails when the field is not set.
+type mockAPI struct {
+	CurrentTokenFunc func(ctx context.Context) (*masthead.TokenInfo, error)
+
+	ListUsersFunc      func(ctx context.Context, filter masthead.UserFilter) ([]masthead.User, error)
+	IterUsersFunc      func(ctx context.Context, filter masthead.UserFilter) iter.Seq2[masthead.User, error]
+	CreateUserFunc     func(ctx context.Context, user masthead.User) (*masthead.User, error)
+	UpdateUserRoleFunc func(ctx context.Context, user masthead.User) (*masthead.User, error)
+	DeleteUserFunc     func(ctx context.Context, email string) error
+
+	ListDomainsFunc  func(ctx context.Context) ([]masthead.DataDomain, error)
+	IterDomainsFunc  func(ctx context.Context) iter.Seq2[masthead.DataDomain, error]
+	CreateDomainFunc func(ctx context.Context, dataDomain masthead.DataDomain) (*masthead.DataDomain, error)
+	GetDomainFunc    func(ctx context.Context, dataDomainID string) (*masthead.DataDomain, error)
+	UpdateDomainFunc func(ctx context.Context, dataDomain masthead.DataDomain) (*masthead.DataDomain, error)
+	DeleteDomainFunc func(ctx context.Context, domainID string) error
+
+	ListDataProductsFunc  func(ctx context.Context) ([]masthead.DataProduct, error)
+	IterDataProductsFunc  func(ctx context.Context) iter.Seq2[masthead.DataProduct, error]
+	CreateDataProductFunc func(ctx context.Context, dataProduct masthead.DataProduct) (*masthead.DataProduct, error)
+	GetDataProductFunc    func(ctx context.Context, productID string) (*masthead.DataProduct, error)
+	UpdateDataProductFunc func(ctx context.Context, dataProduct masthead.DataProduct) (*masthead.DataProduct, error)
+	DeleteDataProductFunc func(ctx context.Context, productID string) error
+}
+
+var _ masthead.MastheadAPI = &mockAPI{}
+
+// errUnexpectedCall is returned by operations the test did not mock
+func errUnexpectedCall(operation string) error {
+	return fmt.Errorf("unexpected call to %s", operation)
+}
+
+// errorSeq returns an iterator yielding a single error
+func errorSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
+// seqOf returns an iterator over items, counting in consumed how many of them
+// were read, so that tests can check that iteration stopped early
+func seqOf[T any](consumed *int, items ...T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			*consumed++
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (m *mockAPI) CurrentToken(ctx context.Context) (*masthead.TokenInfo, error) {
+	if m.CurrentTokenFunc == nil {
+		return nil, errUnexpectedCall("CurrentToken")
+	}
+	return m.CurrentTokenFunc(ctx)
+}
+
+func (m *mockAPI) ListUsers(ctx context.Context, filter masthead.UserFilter) ([]masthead.User, error) {
+	if m.ListUsersFunc == nil {
+		return nil, errUnexpectedCall("ListUsers")
+	}
+	return m.ListUsersFunc(ctx, filter)
+}
+
+func (m *mockAPI) IterUsers(ctx context.Context, filter masthead.UserFilter) iter.Seq2[masthead.User, error] {
+	if m.IterUsersFunc == nil {
+		return errorSeq[masthead.User](errUnexpectedCall("IterUsers"))
+	}
+	return m.IterUsersFunc(ctx, filter)
+}
+
+func (m *mockAPI) CreateUser(ctx context.Context, user masthead.User) (*masthead.User, error) {
+	if m.CreateUserFunc == nil {
+		return nil, errUnexpectedCall("CreateUser")
+	}
+	return m.CreateUserFunc(ctx, user)
+}
+
+func (m *mockAPI) UpdateUserRole(ctx context.Context, user masthead.User) (*masthead.User, error) {
+	if m.UpdateUserRoleFunc == nil {
+		return nil, errUnexpectedCall("UpdateUserRole")
+	}
+	return m.UpdateUserRoleFunc(ctx, user)
+}
+
+func (m *mockAPI) DeleteUser(ctx context.Context, email string) error {
+	if m.DeleteUserFunc == nil {
+		return errUnexpectedCall("DeleteUser")
+	}
+	return m.DeleteUserFunc(ctx, email)
+}
+
+func (m *mockAPI) ListDomains(ctx context.Context) ([]masthead.DataDomain, error) {
+	if m.ListDomainsFunc == nil {
+		return nil, errUnexpectedCall("ListDomains")
+	}
+	return m.ListDomainsFunc(ctx)
+}
+
+func (m *mockAPI) IterDomains(ctx context.Context) iter.Seq2[masthead.DataDomain, error] {
+	if m.IterDomainsFunc == nil {
+		return errorSeq[masthead.DataDomain](errUnexpectedCall("IterDomains"))
+	}
+	return m.IterDomainsFunc(ctx)
+}
+
+func (m *mockAPI) CreateDomain(ctx context.Context, dataDomain masthead.DataDomain) (*masthead.DataDomain, error) {
+	if m.CreateDomainFunc == nil {
+		return nil, errUnexpectedCall("CreateDomain")
+	}
+	return m.CreateDomainFunc(ctx, dataDomain)
+}
+
+func (m *mockAPI) GetDomain(ctx context.Context, dataDomainID string) (*masthead.DataDomain, error) {
+	if m.GetDomainFunc == nil {
+		return nil, errUnexpectedCall("GetDomain")
+	}
+	return m.GetDomainFunc(ctx, dataDomainID)
+}
+
+func (m *mockAPI) UpdateDomain(ctx context.Context, dataDomain masthead.DataDomain) (*masthead.DataDomain, error) {
+	if m.UpdateDomainFunc == nil {
+		return nil, errUnexpectedCall("UpdateDomain")
+	}
+	return m.UpdateDomainFunc(ctx, dataDomain)
+}
+
+func (m *mockAPI) DeleteDomain(ctx context.Context, domainID string) error {
+	if m.DeleteDomainFunc == nil {
+		return errUnexpectedCall("DeleteDomain")
+	}
+	return m.DeleteDomainFunc(ctx, domainID)
+}
+
+func (m *mockAPI) ListDataProducts(ctx context.Context) ([]masthead.DataProduct, error) {
+	if m.ListDataProductsFunc == nil {
+		return nil, errUnexpectedCall("ListDataProducts")
+	}
+	return m.ListDataProductsFunc(ctx)
+}
+
+func (m *mockAPI) IterDataProducts(ctx context.Context) iter.Seq2[masthead.DataProduct, error] {
+	if m.IterDataProductsFunc == nil {
+		return errorSeq[masthead.DataProduct](errUnexpectedCall("IterDataProducts"))
+	}
+	return m.IterDataProductsFunc(ctx)
+}
+
+func (m *mockAPI) CreateDataProduct(ctx context.Context, dataProduct masthead.DataProduct) (*masthead.DataProduct, error) {
+	if m.CreateDataProductFunc == nil {
+		return nil, errUnexpectedCall("CreateDataProduct")
+	}
+	return m.CreateDataProductFunc(ctx, dataProduct)
+}
+
+func (m *mockAPI) GetDataProduct(ctx context.Context, productID string) (*masthead.DataProduct, error) {
+	if m.GetDataProductFunc == nil {
+		return nil, errUnexpectedCall("GetDataProduct")
+	}
+	return m.GetDataProductFunc(ctx, productID)
+}
+
+func (m *mockAPI) UpdateDataProduct(ctx context.Context, dataProduct masthead.DataProduct) (*masthead.DataProduct, error) {
+	if m.UpdateDataProductFunc == nil {
+		return nil, errUnexpectedCall("UpdateDataProduct")
+	}
+	return m.UpdateDataProductFunc(ctx, dataProduct)
+}
+
+func (m *mockAPI) DeleteDataProduct(ctx context.Context, productID string) error {
+	if m.DeleteDataProductFunc == nil {
+		return errUnexpectedCall("DeleteDataProduct")
+	}
+	return m.DeleteDataProductFunc(ctx, productID)
+}
+
+// configuredResource returns the resource configured with the given API
+func configuredResource[R resource.ResourceWithConfigure](t *testing.T, r R, api masthead.MastheadAPI) R {
+	t.Helper()
+
+	var resp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: api}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+	return r
+}
+
+// resourceState returns a state of the resource holding the given model, or
+// a null state when model is nil
+func resourceState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned errors: %v", resp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	if model != nil {
+		if diags := state.Set(context.Background(), model); diags.HasError() {
+			t.Fatalf("Unable to set state: %v", diags)
+		}
+	}
+	return state
+}
+
+// resourcePlan returns a plan of the resource holding the given model
+func resourcePlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	t.Helper()
+
+	state := resourceState(t, r, model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// resourceIdentity returns a null identity of the resource, as pre-populated by
+// the framework in the responses of resources supporting identity
+func resourceIdentity(t *testing.T, r resource.ResourceWithIdentity) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("IdentitySchema returned errors: %v", resp.Diagnostics)
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// configuredListResource returns the list resource configured with the given API
+func configuredListResource[R list.ListResourceWithConfigure](t *testing.T, r R, api masthead.MastheadAPI) R {
+	t.Helper()
+
+	var resp resource.ConfigureResponse
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: api}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+	return r
+}
+
+// listResourceResults lists the resources of a list resource, configured with
+// the given model, and collects the streamed results
+func listResourceResults(t *testing.T, l list.ListResource, r resource.ResourceWithIdentity, model any, limit int64, includeResource bool) []list.ListResult {
+	t.Helper()
+
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(context.Background(), list.ListResourceSchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("ListResourceConfigSchema returned errors: %v", schemaResp.Diagnostics)
+	}
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	state := tfsdk.State(config)
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("Unable to set config: %v", diags)
+	}
+	config.Raw = state.Raw
+
+	req := list.ListRequest{
+		Config:                 config,
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceState(t, r, nil).Schema,
+		ResourceIdentitySchema: resourceIdentity(t, r).Schema,
+	}
+	stream := list.ListResultsStream{}
+	l.List(context.Background(), req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
@@ -2,9 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -15,7 +24,10 @@ import (
 )
 
 // Ensure mastheadProvider satisfies various provider interfaces.
-var _ provider.Provider = &mastheadProvider{}
+var (
+	_ provider.Provider                  = &mastheadProvider{}
+	_ provider.ProviderWithListResources = &mastheadProvider{}
+)
 
 // New is a helper function to simplify provider server and testing implementation.
 func New(version string) func() provider.Provider {
@@ -37,7 +49,27 @@ type mastheadProvider struct {
 
 // mastheadProviderModel maps provider schema data to a Go type.
 type mastheadProviderModel struct {
-	Token types.String `tfsdk:"api_token"`
+	Token           types.String `tfsdk:"api_token"`
+	TokenFile       types.String `tfsdk:"api_token_file"`
+	TokenCommand    types.List   `tfsdk:"api_token_command"`
+	TokenCommandTTL types.String `tfsdk:"api_token_command_ttl"`
+	SkipValidation  types.Bool   `tfsdk:"skip_credentials_validation"`
+	HostURL         types.String `tfsdk:"host_url"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait    types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait    types.String `tfsdk:"retry_max_wait"`
+
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
 }
 
 func (p *mastheadProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -53,11 +85,100 @@ func (p *mastheadProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				MarkdownDescription: "Masthead API Token. This token is used to authenticate with the Masthead API. " +
 					"To obtain a token, log in to your Masthead account and navigate to the **Settings / API Tokens** page. " +
 					"Create a new token and copy it here. " +
-					"Alternatively, you can set the `MASTHEAD_API_TOKEN` environment variable to use the token from there.",
+					"Alternatively, you can set the `MASTHEAD_API_TOKEN` environment variable to use the token from there. " +
+					"Conflicts with `api_token_file` and `api_token_command`.",
 				Required:  false,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the Masthead API token, e.g. a Vault agent sink. " +
+					"The file is read again whenever it changes. " +
+					"Alternatively, you can set the `MASTHEAD_API_TOKEN_FILE` environment variable. " +
+					"Conflicts with `api_token` and `api_token_command`.",
+				Optional: true,
+			},
+			"api_token_command": schema.ListAttribute{
+				MarkdownDescription: "Credential helper command printing the Masthead API token on its standard output, " +
+					"as a list of the program and its arguments, e.g. `[\"vault\", \"kv\", \"get\", \"-field=token\", \"secret/masthead\"]`. " +
+					"The token is reused for `api_token_command_ttl`, or until the API rejects it. " +
+					"Conflicts with `api_token` and `api_token_file`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"api_token_command_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long the token printed by `api_token_command` is reused before running the command again, " +
+					"as a Go duration string (e.g. `15m`, `1h`). Defaults to `5m`.",
+				Optional: true,
+			},
+			"host_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Masthead API, e.g. to target a staging tenant or a local mock server. " +
+					"Must be an absolute `http` or `https` URL. Defaults to `" + masthead.HostURL + "`. " +
+					"Alternatively, you can set the `MASTHEAD_HOST_URL` environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a transient failure " +
+					"(HTTP 429, 502, 503, 504 or a network error). Set to `0` to disable retries. Defaults to `3`.",
+				Optional: true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a request, as a Go duration string (e.g. `500ms`, `2s`). " +
+					"The wait doubles with every attempt, and `0s` retries immediately. A `Retry-After` header sent by the API takes precedence. Defaults to `1s`.",
+				Optional: true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, as a Go duration string (e.g. `30s`, `1m`), including waits requested by a `Retry-After` header. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request, as a Go duration string (e.g. `30s`, `2m`). Must be greater than zero. Defaults to `10s`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle trusted in addition to the system certificates, " +
+					"e.g. for networks with TLS inspection.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle trusted in addition to the system certificates. " +
+					"Can be combined with `ca_cert_file`.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires `client_key`. " +
+					"Use the `file()` function to load it from disk.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS. Requires `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the API server certificate. Only use this for test stacks.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at the same time. " +
+					"The limit applies to each provider configuration, including aliases, separately. Unlimited by default.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum average number of API requests per second, with bursts of up to one second worth of requests. " +
+					"The limit applies to each provider configuration, including aliases, separately. Unlimited by default.",
+				Optional: true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the API request checking that the API token is valid when the provider is configured, " +
+					"e.g. to plan without network access to the Masthead API. An invalid token is then only reported by the first resource or data source using it.",
+				Optional: true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the `User-Agent` header sent to the Masthead API, e.g. a team or pipeline name, " +
+					"to attribute API traffic. The header always starts with `terraform-provider-masthead/<version> (+terraform <version>)`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -74,12 +195,12 @@ func (p *mastheadProvider) Configure(ctx context.Context, req provider.Configure
 	// If practitioner provided a configuration value for any of the
 	// attributes, it must be a known value.
 
-	if config.Token.IsUnknown() {
+	if config.HostURL.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Unknown Masthead API Token",
-			"The provider cannot create the Masthead API client as there is an unknown configuration value for the Masthead API token. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the MASTHEAD_API_TOKEN environment variable.",
+			path.Root("host_url"),
+			"Unknown Masthead API Host URL",
+			"The provider cannot create the Masthead API client as there is an unknown configuration value for the Masthead API host URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the MASTHEAD_HOST_URL environment variable.",
 		)
 	}
 
@@ -90,22 +211,90 @@ func (p *mastheadProvider) Configure(ctx context.Context, req provider.Configure
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
-	api_token := os.Getenv("MASTHEAD_API_TOKEN")
+	api_token, tokenSource := configureToken(ctx, config, &resp.Diagnostics)
+
+	host_url := os.Getenv(masthead.HostURLEnvVar)
 
-	if !config.Token.IsNull() {
-		api_token = config.Token.ValueString()
+	if !config.HostURL.IsNull() {
+		host_url = config.HostURL.ValueString()
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if api_token == "" {
+	if host_url == "" {
+		host_url = masthead.HostURL
+	} else if err := validateHostURL(host_url); err != nil {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Missing Masthead API Token",
-			"The provider cannot create the Masthead API client as there is a missing or empty value for the Masthead API token. "+
-				"Set the token value in the configuration or use the MASTHEAD_API_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("host_url"),
+			"Invalid Masthead API Host URL",
+			fmt.Sprintf("The Masthead API host URL %q is invalid: %s. "+
+				"Set an absolute URL such as \"https://metadata.mastheadata.com\" in the configuration or the MASTHEAD_HOST_URL environment variable.", host_url, err),
+		)
+	}
+
+	// Retry policy, starting from the client defaults
+	maxRetries := masthead.DefaultMaxRetries
+	retryMinWait := masthead.DefaultRetryMinWait
+	retryMaxWait := masthead.DefaultRetryMaxWait
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"The max_retries value must be zero or greater.",
+			)
+		}
+	}
+
+	retryMinWait = parseDurationAttribute(config.RetryMinWait, path.Root("retry_min_wait"), retryMinWait, &resp.Diagnostics)
+	retryMaxWait = parseDurationAttribute(config.RetryMaxWait, path.Root("retry_max_wait"), retryMaxWait, &resp.Diagnostics)
+
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("The retry_min_wait value (%s) must not be greater than retry_max_wait (%s).", retryMinWait, retryMaxWait),
+		)
+	}
+
+	requestTimeout := parseDurationAttribute(config.RequestTimeout, path.Root("request_timeout"), masthead.DefaultTimeout, &resp.Diagnostics)
+	if requestTimeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid Request Timeout",
+			"The request_timeout value must be greater than zero.",
+		)
+	}
+
+	transport := buildTransport(config, &resp.Diagnostics)
+
+	maxConcurrentRequests := int(config.MaxConcurrentRequests.ValueInt64())
+	if maxConcurrentRequests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			"The max_concurrent_requests value must be zero (unlimited) or greater.",
+		)
+	}
+
+	requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Requests Per Second",
+			"The requests_per_second value must be zero (unlimited) or greater.",
+		)
+	}
+
+	userAgentSuffix := config.UserAgentSuffix.ValueString()
+	if strings.IndexFunc(userAgentSuffix, func(r rune) bool { return r < ' ' || r > '~' }) >= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_agent_suffix"),
+			"Invalid User-Agent Suffix",
+			"The user_agent_suffix value must only contain printable ASCII characters.",
 		)
 	}
 
@@ -114,7 +303,19 @@ func (p *mastheadProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	// Create a new Masthead client using the configuration values
-	client, err := masthead.NewClient(&api_token)
+	opts := []masthead.Option{
+		masthead.WithHostURL(host_url),
+		masthead.WithRetry(maxRetries, retryMinWait, retryMaxWait),
+		masthead.WithTimeout(requestTimeout),
+		masthead.WithTransport(transport),
+		masthead.WithLimiter(masthead.NewLimiter(maxConcurrentRequests, requestsPerSecond)),
+		masthead.WithUserAgent(userAgent(p.version, req.TerraformVersion, userAgentSuffix)),
+	}
+	if tokenSource != nil {
+		opts = append(opts, masthead.WithTokenSource(tokenSource))
+	}
+
+	client, err := masthead.NewClient(&api_token, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Masthead API Client",
@@ -125,10 +326,275 @@ func (p *mastheadProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Make the Masthead client available during DataSource and Resource
-	// type Configure methods.
+	// Check the token now, rather than failing with a 401 in whichever
+	// resource runs first
+	if !config.SkipValidation.ValueBool() {
+		validateCredentials(ctx, client, config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Make the Masthead client available during DataSource, Resource and
+	// ListResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
+}
+
+// configureToken resolves where the API token comes from. It returns either a
+// static token or a token source.
+//
+// At most one of api_token, api_token_file and api_token_command can be set.
+// When none is set, the MASTHEAD_API_TOKEN environment variable is used, then
+// MASTHEAD_API_TOKEN_FILE.
+func configureToken(ctx context.Context, config mastheadProviderModel, diags *diag.Diagnostics) (string, masthead.TokenSource) {
+	sources := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"api_token", config.Token},
+		{"api_token_file", config.TokenFile},
+		{"api_token_command", config.TokenCommand},
+	}
+
+	var configured []string
+	for _, source := range sources {
+		if source.value.IsUnknown() {
+			diags.AddAttributeError(
+				path.Root(source.name),
+				"Unknown Masthead API Token",
+				fmt.Sprintf("The provider cannot create the Masthead API client as there is an unknown configuration value for %s. ", source.name)+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the MASTHEAD_API_TOKEN environment variable.",
+			)
+			return "", nil
+		}
+		if !source.value.IsNull() {
+			configured = append(configured, source.name)
+		}
+	}
+	if len(configured) > 1 {
+		diags.AddAttributeError(
+			path.Root(configured[1]),
+			"Conflicting Masthead API Token Sources",
+			fmt.Sprintf("Only one of api_token, api_token_file and api_token_command can be set, got %s.", strings.Join(configured, " and ")),
+		)
+		return "", nil
+	}
+
+	tokenFile := os.Getenv(masthead.TokenFileEnvVar)
+	switch {
+	case !config.Token.IsNull():
+		if token := config.Token.ValueString(); token != "" {
+			return token, nil
+		}
+		tokenFile = ""
+
+	case !config.TokenFile.IsNull():
+		tokenFile = config.TokenFile.ValueString()
+
+	case !config.TokenCommand.IsNull():
+		var command []string
+		diags.Append(config.TokenCommand.ElementsAs(ctx, &command, false)...)
+		if diags.HasError() {
+			return "", nil
+		}
+		if len(command) == 0 || command[0] == "" {
+			diags.AddAttributeError(
+				path.Root("api_token_command"),
+				"Invalid Masthead API Token Command",
+				"The api_token_command value must start with the program to run.",
+			)
+			return "", nil
+		}
+		if _, err := exec.LookPath(command[0]); err != nil {
+			diags.AddAttributeError(
+				path.Root("api_token_command"),
+				"Invalid Masthead API Token Command",
+				fmt.Sprintf("The API token command cannot be run: %s", err),
+			)
+			return "", nil
+		}
+
+		ttl := parseDurationAttribute(config.TokenCommandTTL, path.Root("api_token_command_ttl"), masthead.DefaultTokenCommandTTL, diags)
+		if ttl <= 0 {
+			diags.AddAttributeError(
+				path.Root("api_token_command_ttl"),
+				"Invalid API Token Command TTL",
+				"The api_token_command_ttl value must be greater than zero.",
+			)
+		}
+		return "", masthead.NewCommandTokenSource(command, ttl)
+
+	case os.Getenv(masthead.TokenEnvVar) != "":
+		token := os.Getenv(masthead.TokenEnvVar)
+		return token, nil
+	}
+
+	if tokenFile == "" {
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Missing Masthead API Token",
+			"The provider cannot create the Masthead API client as there is a missing or empty value for the Masthead API token. "+
+				"Set the token value in the configuration with api_token, api_token_file or api_token_command, "+
+				"or use the MASTHEAD_API_TOKEN or MASTHEAD_API_TOKEN_FILE environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+		return "", nil
+	}
+
+	// Read the file once to report a missing or empty file early
+	source := masthead.NewFileTokenSource(tokenFile)
+	if _, err := source.Token(ctx); err != nil {
+		diags.AddAttributeError(
+			path.Root("api_token_file"),
+			"Unable to Read Masthead API Token File",
+			fmt.Sprintf("The provider cannot read the Masthead API token: %s", err),
+		)
+		return "", nil
+	}
+	return "", source
+}
+
+// validateCredentials checks that the API accepts the token, reporting a
+// rejected token on the attribute it was configured with
+func validateCredentials(ctx context.Context, client *masthead.Client, config mastheadProviderModel, diags *diag.Diagnostics) {
+	err := client.ValidateCredentials(ctx)
+	if err == nil {
+		return
+	}
+
+	attribute := path.Root("api_token")
+	switch {
+	case !config.TokenFile.IsNull():
+		attribute = path.Root("api_token_file")
+	case !config.TokenCommand.IsNull():
+		attribute = path.Root("api_token_command")
+	}
+
+	switch {
+	case masthead.IsUnauthorized(err):
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Masthead API Token",
+			"The Masthead API rejected the API token, it may be mistyped, revoked or expired. "+
+				"Create a new token on the Settings / API Tokens page of your Masthead account.\n\n"+
+				"Masthead Client Error: "+err.Error(),
+		)
+	case masthead.IsForbidden(err):
+		diags.AddAttributeError(
+			attribute,
+			"Insufficient Masthead API Token Permissions",
+			"The Masthead API token is valid but is not allowed to list users, which the provider uses to validate credentials. "+
+				"Use a token with access to the account, or set skip_credentials_validation to true.\n\n"+
+				"Masthead Client Error: "+err.Error(),
+		)
+	default:
+		diags.AddError(
+			"Unable to Validate Masthead API Credentials",
+			"An unexpected error occurred when checking the Masthead API token. "+
+				"Set skip_credentials_validation to true to configure the provider without contacting the API.\n\n"+
+				"Masthead Client Error: "+err.Error(),
+		)
+	}
+}
+
+// buildTransport creates the HTTP transport from the TLS related provider attributes
+func buildTransport(config mastheadProviderModel, diags *diag.Diagnostics) http.RoundTripper {
+	var opts masthead.TLSOptions
+
+	if !config.CACertFile.IsNull() {
+		pem, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate File",
+				fmt.Sprintf("The CA certificate file could not be read: %s", err),
+			)
+			return nil
+		}
+		opts.CACertPEM = append(opts.CACertPEM, pem...)
+	}
+
+	if !config.CACertPEM.IsNull() {
+		opts.CACertPEM = append(opts.CACertPEM, '\n')
+		opts.CACertPEM = append(opts.CACertPEM, config.CACertPEM.ValueString()...)
+	}
+
+	if config.ClientCert.IsNull() != config.ClientKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Client Certificate",
+			"Both client_cert and client_key must be set to use mutual TLS.",
+		)
+		return nil
+	}
+
+	opts.ClientCertPEM = []byte(config.ClientCert.ValueString())
+	opts.ClientKeyPEM = []byte(config.ClientKey.ValueString())
+	opts.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+
+	transport, err := masthead.NewTransport(opts)
+	if err != nil {
+		diags.AddError(
+			"Invalid TLS Configuration",
+			fmt.Sprintf("The provider cannot configure TLS for the Masthead API client: %s", err),
+		)
+		return nil
+	}
+
+	return transport
+}
+
+// userAgent builds the User-Agent header identifying the provider and Terraform versions,
+// e.g. "terraform-provider-masthead/1.2.0 (+terraform 1.9.5) team-data"
+func userAgent(providerVersion, terraformVersion, suffix string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+	userAgent := fmt.Sprintf("terraform-provider-masthead/%s (+terraform %s)", providerVersion, terraformVersion)
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		userAgent += " " + suffix
+	}
+	return userAgent
+}
+
+// validateHostURL checks that the API host URL is an absolute http(s) URL
+func validateHostURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("host is missing")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("query and fragment are not allowed")
+	}
+	return nil
+}
+
+// parseDurationAttribute parses an optional duration attribute, returning the
+// default value when it is not set and adding a diagnostic when it is invalid.
+func parseDurationAttribute(value types.String, attrPath path.Path, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid Duration",
+			fmt.Sprintf("The value %q is not a valid non-negative duration. Use a Go duration string such as \"500ms\", \"10s\" or \"1m\".", value.ValueString()),
+		)
+		return defaultValue
+	}
+
+	return duration
 }
 
 func (p *mastheadProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -139,10 +605,22 @@ func (p *mastheadProvider) Resources(ctx context.Context) []func() resource.Reso
 	}
 }
 
+func (p *mastheadProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewUserListResource,
+		NewDataDomainListResource,
+		NewDataProductListResource,
+	}
+}
+
 func (p *mastheadProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCurrentTokenDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewDataDomainDataSource,
+		NewDataDomainsDataSource,
 		NewDataProductDataSource,
+		NewDataProductsDataSource,
 	}
 }
@@ -1,20 +1,23 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-)
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 
-const (
-	// providerConfig is a shared configuration to combine with the actual
-	// test configuration so the HashiCups client is properly configured.
-	// It is also possible to use the HASHICUPS_ environment variables instead,
-	// such as updating the Makefile and running the testing through that tool.
-	providerConfig = `
-provider "masthead" {
-  api_token = "12345678"
-}
-`
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
 )
 
 var (
@@ -26,3 +29,240 @@ var (
 		"masthead": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccServer starts an in-memory fake Masthead API for the duration of the test,
+// so that acceptance tests run without network access or credentials.
+func testAccServer(t *testing.T) *fakeapi.Server {
+	t.Helper()
+
+	server := fakeapi.New()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testAccProviderConfig returns a provider configuration pointing to the fake API,
+// with fast retries so that injected faults do not slow tests down, to combine
+// with the actual test configuration.
+func testAccProviderConfig(server *fakeapi.Server) string {
+	return fmt.Sprintf(`
+provider "masthead" {
+  api_token      = %q
+  host_url       = %q
+  max_retries    = 2
+  retry_min_wait = "1ms"
+  retry_max_wait = "10ms"
+}
+`, server.Token, server.URL)
+}
+
+func TestAccProvider_tokenSources(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "owner@example.com", Role: "OWNER"})
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(server.Token+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(credentials string) string {
+		return fmt.Sprintf(`
+provider "masthead" {
+  %s
+  host_url = %q
+}
+
+data "masthead_user" "test" {
+  email = "owner@example.com"
+}
+`, credentials, server.URL)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid credentials are reported when configuring the provider
+			{
+				Config:      config(fmt.Sprintf("api_token = %q\n  api_token_file = %q", server.Token, tokenFile)),
+				ExpectError: regexp.MustCompile("Conflicting Masthead API Token Sources"),
+			},
+			{
+				Config:      config(fmt.Sprintf("api_token_file = %q", tokenFile+".missing")),
+				ExpectError: regexp.MustCompile("Unable to Read Masthead API Token File"),
+			},
+			{
+				Config:      config(`api_token_command = ["masthead-missing-credential-helper"]`),
+				ExpectError: regexp.MustCompile("Invalid Masthead API Token Command"),
+			},
+			{
+				Config: config(fmt.Sprintf("api_token_file = %q", tokenFile)),
+				Check:  resource.TestCheckResourceAttr("data.masthead_user.test", "role", "OWNER"),
+			},
+			{
+				Config: config(fmt.Sprintf("api_token_command = [\"echo\", %q]\n  api_token_command_ttl = \"1m\"", server.Token)),
+				Check:  resource.TestCheckResourceAttr("data.masthead_user.test", "role", "OWNER"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_credentialsValidation(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "owner@example.com", Role: "OWNER"})
+
+	config := func(settings string) string {
+		return fmt.Sprintf(`
+provider "masthead" {
+  %s
+  host_url = %q
+}
+
+data "masthead_user" "test" {
+  email = "owner@example.com"
+}
+`, settings, server.URL)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A rejected token is reported when configuring the provider
+			{
+				Config:      config(`api_token = "revoked-token"`),
+				ExpectError: regexp.MustCompile("Invalid Masthead API Token"),
+			},
+			// Without validation, it is only reported by the data source
+			{
+				Config:      config("api_token = \"revoked-token\"\n  skip_credentials_validation = true"),
+				ExpectError: regexp.MustCompile("status: 401"),
+			},
+			{
+				Config: config(fmt.Sprintf("api_token = %q", server.Token)),
+				Check:  resource.TestCheckResourceAttr("data.masthead_user.test", "role", "OWNER"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_durations(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "owner@example.com", Role: "OWNER"})
+
+	config := func(settings string) string {
+		return fmt.Sprintf(`
+provider "masthead" {
+  api_token = %q
+  host_url  = %q
+  %s
+}
+
+data "masthead_user" "test" {
+  email = "owner@example.com"
+}
+`, server.Token, server.URL, settings)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A zero timeout would disable the HTTP client timeout
+			{
+				Config:      config(`request_timeout = "0s"`),
+				ExpectError: regexp.MustCompile("Invalid Request Timeout"),
+			},
+			{
+				Config:      config(`retry_min_wait = "-1s"`),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			// A zero minimum wait retries immediately
+			{
+				Config: config("retry_min_wait = \"0s\"\n  request_timeout = \"5s\""),
+				Check:  resource.TestCheckResourceAttr("data.masthead_user.test", "role", "OWNER"),
+			},
+		},
+	})
+}
+
+func TestConfigureToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := mastheadProviderModel{
+		Token:           types.StringNull(),
+		TokenFile:       types.StringNull(),
+		TokenCommand:    types.ListNull(types.StringType),
+		TokenCommandTTL: types.StringNull(),
+	}
+
+	// MASTHEAD_API_TOKEN takes precedence over MASTHEAD_API_TOKEN_FILE
+	t.Setenv(masthead.TokenEnvVar, "env-token")
+	t.Setenv(masthead.TokenFileEnvVar, tokenFile)
+	var diags diag.Diagnostics
+	token, source := configureToken(context.Background(), config, &diags)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "env-token", token)
+	assert.Nil(t, source)
+
+	t.Setenv(masthead.TokenEnvVar, "")
+	token, source = configureToken(context.Background(), config, &diags)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, token)
+	if assert.NotNil(t, source) {
+		value, err := source.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "file-token", value)
+	}
+
+	// Configuration values take precedence over the environment
+	config.Token = types.StringValue("config-token")
+	token, source = configureToken(context.Background(), config, &diags)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "config-token", token)
+	assert.Nil(t, source)
+
+	t.Setenv(masthead.TokenFileEnvVar, "")
+	config.Token = types.StringNull()
+	configureToken(context.Background(), config, &diags)
+	assert.True(t, diags.HasError(), "A missing token should be reported")
+}
+
+func TestValidateHostURL(t *testing.T) {
+	valid := []string{
+		"https://metadata.mastheadata.com",
+		"https://staging.mastheadata.com/",
+		"http://127.0.0.1:8080",
+	}
+	for _, value := range valid {
+		if err := validateHostURL(value); err != nil {
+			t.Errorf("validateHostURL(%q) returned unexpected error: %s", value, err)
+		}
+	}
+
+	invalid := []string{
+		"metadata.mastheadata.com",
+		"ftp://metadata.mastheadata.com",
+		"https://",
+		"https://metadata.mastheadata.com?tenant=1",
+		"/clientApi",
+	}
+	for _, value := range invalid {
+		if err := validateHostURL(value); err == nil {
+			t.Errorf("validateHostURL(%q) expected an error", value)
+		}
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		providerVersion, terraformVersion, suffix, expected string
+	}{
+		{"1.2.0", "1.9.5", "", "terraform-provider-masthead/1.2.0 (+terraform 1.9.5)"},
+		{"1.2.0", "1.9.5", " data-platform ", "terraform-provider-masthead/1.2.0 (+terraform 1.9.5) data-platform"},
+		{"dev", "", "", "terraform-provider-masthead/dev (+terraform unknown)"},
+	}
+	for _, test := range tests {
+		if actual := userAgent(test.providerVersion, test.terraformVersion, test.suffix); actual != test.expected {
+			t.Errorf("userAgent(%q, %q, %q) = %q, expected %q", test.providerVersion, test.terraformVersion, test.suffix, actual, test.expected)
+		}
+	}
+}
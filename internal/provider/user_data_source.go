@@ -19,7 +19,7 @@ func NewUserDataSource() datasource.DataSource {
 
 // UserDataSource defines the data source implementation.
 type UserDataSource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
 }
 
 func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -48,11 +48,11 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -71,7 +71,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Get all users from Masthead API
-	usersResponse, err := d.client.ListUsers()
+	usersResponse, err := d.client.ListUsers(ctx, masthead.UserFilter{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
 		return
@@ -82,7 +82,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	for _, user := range usersResponse {
 		if config.Email.ValueString() == user.Email {
 			state.Email = types.StringValue(user.Email)
-			state.Role = user.Role
+			state.Role = types.StringValue(string(user.Role))
 			found = true
 			break
 		}
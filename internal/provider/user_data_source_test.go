@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "owner@example.com", Role: "OWNER"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_user" "test" {
+  email = "owner@example.com"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_user.test", tfjsonpath.New("email"), knownvalue.StringExact("owner@example.com")),
+					statecheck.ExpectKnownValue("data.masthead_user.test", tfjsonpath.New("role"), knownvalue.StringExact("OWNER")),
+				},
+				Check: func(*terraform.State) error {
+					if ua := server.UserAgent(); !regexp.MustCompile(`^terraform-provider-masthead/test \(\+terraform \d+\.\d+\.\d+[^)]*\)$`).MatchString(ua) {
+						return fmt.Errorf("unexpected User-Agent %q", ua)
+					}
+					return nil
+				},
+			},
+			// The User-Agent suffix is appended to the provider and Terraform versions
+			{
+				Config: strings.Replace(testAccProviderConfig(server), "provider \"masthead\" {", "provider \"masthead\" {\n  user_agent_suffix = \"data-platform\"", 1) + `
+data "masthead_user" "test" {
+  email = "owner@example.com"
+}
+`,
+				Check: func(*terraform.State) error {
+					if ua := server.UserAgent(); !strings.HasSuffix(ua, ") data-platform") {
+						return fmt.Errorf("unexpected User-Agent %q", ua)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_user" "test" {
+  email = "missing@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("User Not Found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ list.ListResourceWithConfigure = &UserListResource{}
+
+func NewUserListResource() list.ListResource {
+	return &UserListResource{}
+}
+
+// UserListResource lists the users of the account, e.g. for terraform query.
+type UserListResource struct {
+	client masthead.MastheadAPI
+}
+
+// UserListResourceModel describes the list configuration.
+type UserListResourceModel struct {
+	Role types.String `tfsdk:"role"`
+}
+
+func (r *UserListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists Masthead users",
+		Attributes: map[string]listschema.Attribute{
+			"role": listschema.StringAttribute{
+				MarkdownDescription: "Only list users with this role (supported values: USER, OWNER)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(masthead.UserRoleUser), string(masthead.UserRoleOwner)),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config UserListResourceModel
+	var diags diag.Diagnostics
+
+	// The config block of list blocks is optional
+	if !req.Config.Raw.IsNull() {
+		diags.Append(req.Config.Get(ctx, &config)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	role := masthead.UserRole(config.Role.ValueString())
+	users := r.client.IterUsers(ctx, masthead.UserFilter{Role: role})
+
+	// The API is not known to apply the role filter, so check it here as well
+	match := func(user masthead.User) bool {
+		return role == "" || user.Role == role
+	}
+
+	stream.Results = listResults(ctx, req, "users", users, match, func(user masthead.User, result *list.ListResult) {
+		result.DisplayName = user.Email
+		result.Diagnostics.Append(result.Identity.Set(ctx, UserResourceIdentityModel{Email: types.StringValue(user.Email)})...)
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, UserResourceModel{
+				Email: types.StringValue(user.Email),
+				Role:  types.StringValue(string(user.Role)),
+			})...)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccUserListResource(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "owner@example.com", Role: "OWNER"})
+	server.AddUser(fakeapi.User{Email: "analyst@example.com", Role: "USER"})
+	server.AddUser(fakeapi.User{Email: "engineer@example.com", Role: "USER"})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The provider configuration of the first step is reused by query steps
+			{
+				Config: testAccProviderConfig(server),
+			},
+			{
+				Query: true,
+				Config: `
+list "masthead_user" "all" {
+  provider = masthead
+}
+
+list "masthead_user" "users" {
+  provider         = masthead
+  include_resource = true
+
+  config {
+    role = "USER"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("masthead_user.all", 3),
+					querycheck.ExpectLength("masthead_user.users", 2),
+					querycheck.ExpectNoIdentity("masthead_user.users", map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("owner@example.com"),
+					}),
+					querycheck.ExpectResourceDisplayName("masthead_user.users", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("analyst@example.com"),
+					}), knownvalue.StringExact("analyst@example.com")),
+					querycheck.ExpectResourceKnownValues("masthead_user.users", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"email": knownvalue.StringExact("engineer@example.com"),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("role"), KnownValue: knownvalue.StringExact("USER")},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestUserListResourceList(t *testing.T) {
+	var filters []masthead.UserFilter
+	var consumed int
+	api := &mockAPI{
+		IterUsersFunc: func(ctx context.Context, filter masthead.UserFilter) iter.Seq2[masthead.User, error] {
+			filters = append(filters, filter)
+			// The owner is dropped even if the API ignores the role filter
+			return seqOf(&consumed,
+				masthead.User{Email: "owner@example.com", Role: masthead.UserRoleOwner},
+				masthead.User{Email: "analyst@example.com", Role: masthead.UserRoleUser},
+				masthead.User{Email: "engineer@example.com", Role: masthead.UserRoleUser},
+			)
+		},
+	}
+	r := configuredListResource(t, &UserListResource{}, api)
+
+	results := listResourceResults(t, r, &UserResource{}, UserListResourceModel{Role: types.StringValue("USER")}, 1, true)
+	assert.Equal(t, []masthead.UserFilter{{Role: masthead.UserRoleUser}}, filters)
+	assert.Equal(t, 2, consumed, "No user should be read past the limit")
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "analyst@example.com", results[0].DisplayName)
+		var model UserResourceModel
+		results[0].Resource.Get(context.Background(), &model)
+		assert.Equal(t, UserResourceModel{Email: types.StringValue("analyst@example.com"), Role: types.StringValue("USER")}, model)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -16,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -23,13 +25,18 @@ func NewUserResource() resource.Resource {
 
 // UserResource defines the resource implementation.
 type UserResource struct {
-	client *masthead.Client
+	client masthead.MastheadAPI
 }
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	Email types.String      `tfsdk:"email"`
-	Role  masthead.UserRole `tfsdk:"role"`
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+// UserResourceIdentityModel describes the identity of the resource.
+type UserResourceIdentityModel struct {
+	Email types.String `tfsdk:"email"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,17 +62,28 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	}
 }
 
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				Description:       "Email address of the user",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*masthead.Client)
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *masthead.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -86,11 +104,11 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create user object
 	userRequest := masthead.User{
 		Email: plan.Email.ValueString(),
-		Role:  plan.Role,
+		Role:  masthead.UserRole(plan.Role.ValueString()),
 	}
 
 	// Create new user
-	userResponse, err := r.client.CreateUser(userRequest)
+	userResponse, err := r.client.CreateUser(ctx, userRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
 		return
@@ -98,10 +116,11 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Map API response to model
 	state.Email = types.StringValue(userResponse.Email)
-	state.Role = userResponse.Role
+	state.Role = types.StringValue(string(userResponse.Role))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserResourceIdentityModel{Email: state.Email})...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -114,7 +133,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get all users and find the one with matching email
-	users, err := r.client.ListUsers()
+	users, err := r.client.ListUsers(ctx, masthead.UserFilter{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
 		return
@@ -124,7 +143,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	found := false
 	for _, user := range users {
 		if user.Email == data.Email.ValueString() {
-			data.Role = user.Role
+			data.Role = types.StringValue(string(user.Role))
 			found = true
 			break
 		}
@@ -138,6 +157,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserResourceIdentityModel{Email: data.Email})...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -152,10 +172,10 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	userRequest := masthead.User{
 		Email: plan.Email.ValueString(),
-		Role:  plan.Role,
+		Role:  masthead.UserRole(plan.Role.ValueString()),
 	}
 
-	userResponse, err := r.client.UpdateUserRole(userRequest)
+	userResponse, err := r.client.UpdateUserRole(ctx, userRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
 		return
@@ -163,10 +183,11 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Map API response to model
 	state.Email = types.StringValue(userResponse.Email)
-	state.Role = userResponse.Role
+	state.Role = types.StringValue(string(userResponse.Role))
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserResourceIdentityModel{Email: state.Email})...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -179,13 +200,14 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete user
-	err := r.client.DeleteUser(state.Email.ValueString())
+	err := r.client.DeleteUser(ctx, state.Email.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
 		return
 	}
 }
 
+// ImportState accepts the email address of the user, as import ID or identity
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("email"), path.Root("email"), req, resp)
 }
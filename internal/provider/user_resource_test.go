@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+func TestAccUserResource(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccUserResourceConfig("jane@example.com", "USER"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("masthead_user.test", tfjsonpath.New("email")),
+					statecheck.ExpectKnownValue("masthead_user.test", tfjsonpath.New("email"), knownvalue.StringExact("jane@example.com")),
+					statecheck.ExpectKnownValue("masthead_user.test", tfjsonpath.New("role"), knownvalue.StringExact("USER")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "masthead_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "jane@example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Import by identity, with an import block
+			{
+				ResourceName:    "masthead_user.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccUserResourceConfig("jane@example.com", "OWNER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("masthead_user.test", tfjsonpath.New("role"), knownvalue.StringExact("OWNER")),
+				},
+			},
+			// Drift testing: the user is recreated after being deleted outside of Terraform
+			{
+				PreConfig: func() {
+					server.RemoveUser("jane@example.com")
+				},
+				Config: testAccProviderConfig(server) + testAccUserResourceConfig("jane@example.com", "OWNER"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("masthead_user.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(*terraform.State) error {
+						if _, ok := server.User("jane@example.com"); !ok {
+							return fmt.Errorf("user was not recreated")
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := server.User("jane@example.com"); ok {
+				return fmt.Errorf("user still exists")
+			}
+			return nil
+		},
+	})
+}
+
+func testAccUserResourceConfig(email, role string) string {
+	return fmt.Sprintf(`
+resource "masthead_user" "test" {
+  email = %[1]q
+  role  = %[2]q
+}
+`, email, role)
+}
+
+func TestUserResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	api := &mockAPI{
+		CreateUserFunc: func(ctx context.Context, user masthead.User) (*masthead.User, error) {
+			assert.Equal(t, masthead.User{Email: "jane@example.com", Role: masthead.UserRoleOwner}, user)
+			return &user, nil
+		},
+	}
+	r := configuredResource(t, &UserResource{}, api)
+
+	plan := UserResourceModel{Email: types.StringValue("jane@example.com"), Role: types.StringValue("OWNER")}
+	resp := fwresource.CreateResponse{State: resourceState(t, r, nil), Identity: resourceIdentity(t, r)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: resourcePlan(t, r, &plan)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state UserResourceModel
+	resp.State.Get(ctx, &state)
+	assert.Equal(t, plan, state)
+
+	var identity UserResourceIdentityModel
+	resp.Identity.Get(ctx, &identity)
+	assert.Equal(t, types.StringValue("jane@example.com"), identity.Email)
+
+	// API errors are reported as diagnostics
+	api.CreateUserFunc = func(ctx context.Context, user masthead.User) (*masthead.User, error) {
+		return nil, &masthead.APIError{StatusCode: 409, Code: "USER_ALREADY_EXISTS"}
+	}
+	resp = fwresource.CreateResponse{State: resourceState(t, r, nil), Identity: resourceIdentity(t, r)}
+	r.Create(ctx, fwresource.CreateRequest{Plan: resourcePlan(t, r, &plan)}, &resp)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "USER_ALREADY_EXISTS")
+}
+
+func TestUserResourceRead(t *testing.T) {
+	ctx := context.Background()
+	api := &mockAPI{
+		ListUsersFunc: func(ctx context.Context, filter masthead.UserFilter) ([]masthead.User, error) {
+			return []masthead.User{{Email: "jane@example.com", Role: masthead.UserRoleUser}}, nil
+		},
+	}
+	r := configuredResource(t, &UserResource{}, api)
+
+	// The role is refreshed from the API
+	prior := UserResourceModel{Email: types.StringValue("jane@example.com"), Role: types.StringValue("OWNER")}
+	resp := fwresource.ReadResponse{State: resourceState(t, r, &prior), Identity: resourceIdentity(t, r)}
+	r.Read(ctx, fwresource.ReadRequest{State: resourceState(t, r, &prior)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var state UserResourceModel
+	resp.State.Get(ctx, &state)
+	assert.Equal(t, types.StringValue("USER"), state.Role)
+
+	// A user deleted outside of Terraform is removed from state
+	prior.Email = types.StringValue("john@example.com")
+	resp = fwresource.ReadResponse{State: resourceState(t, r, &prior), Identity: resourceIdentity(t, r)}
+	r.Read(ctx, fwresource.ReadRequest{State: resourceState(t, r, &prior)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "Missing user should be removed from state")
+}
+
+func TestUserResourceConfigure(t *testing.T) {
+	var resp fwresource.ConfigureResponse
+	(&UserResource{}).Configure(context.Background(), fwresource.ConfigureRequest{ProviderData: "not a client"}, &resp)
+	assert.True(t, resp.Diagnostics.HasError(), "Unexpected provider data should be reported")
+}
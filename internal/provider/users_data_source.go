@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	masthead "github.com/masthead-data/terraform-provider-masthead/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client masthead.MastheadAPI
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	Role       types.String        `tfsdk:"role"`
+	EmailRegex types.String        `tfsdk:"email_regex"`
+	Users      []UserResourceModel `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch the Masthead users matching the filters, sorted by email address",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return users with this role (supported values: USER, OWNER)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(masthead.UserRoleUser), string(masthead.UserRoleOwner)),
+				},
+			},
+			"email_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose email address matches this [regular expression](https://pkg.go.dev/regexp/syntax)",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Users matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the user (supported values: USER, OWNER)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(masthead.MastheadAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected masthead.MastheadAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	emailRegex := compileRegexFilter(path.Root("email_regex"), state.EmailRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role filter is sent to the API, but checked here as well as the API
+	// is not known to apply it
+	role := masthead.UserRole(state.Role.ValueString())
+	usersResponse, err := d.client.ListUsers(ctx, masthead.UserFilter{Role: role})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+		return
+	}
+
+	state.Users = []UserResourceModel{}
+	for _, user := range usersResponse {
+		if role != "" && user.Role != role {
+			continue
+		}
+		if !matchesRegexFilter(emailRegex, user.Email) {
+			continue
+		}
+		state.Users = append(state.Users, UserResourceModel{
+			Email: types.StringValue(user.Email),
+			Role:  types.StringValue(string(user.Role)),
+		})
+	}
+	slices.SortFunc(state.Users, func(a, b UserResourceModel) int {
+		return cmp.Compare(a.Email.ValueString(), b.Email.ValueString())
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
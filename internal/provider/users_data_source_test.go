@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/masthead-data/terraform-provider-masthead/internal/fakeapi"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	server := testAccServer(t)
+	server.AddUser(fakeapi.User{Email: "zoe@example.com", Role: "OWNER"})
+	server.AddUser(fakeapi.User{Email: "analyst@example.com", Role: "USER"})
+	server.AddUser(fakeapi.User{Email: "admin@example.com", Role: "OWNER"})
+	server.AddUser(fakeapi.User{Email: "contractor@partner.com", Role: "OWNER"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_users" "test" {
+  email_regex = "["
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_users" "test" {
+  role = "ADMIN"
+}
+`,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+data "masthead_users" "all" {}
+
+data "masthead_users" "owners" {
+  role        = "OWNER"
+  email_regex = "@example\\.com$"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.masthead_users.all", tfjsonpath.New("users"), knownvalue.ListSizeExact(4)),
+					statecheck.ExpectKnownValue("data.masthead_users.all", tfjsonpath.New("users").AtSliceIndex(0).AtMapKey("email"), knownvalue.StringExact("admin@example.com")),
+					statecheck.ExpectKnownValue("data.masthead_users.owners", tfjsonpath.New("users"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"email": knownvalue.StringExact("admin@example.com"),
+							"role":  knownvalue.StringExact("OWNER"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"email": knownvalue.StringExact("zoe@example.com"),
+							"role":  knownvalue.StringExact("OWNER"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}